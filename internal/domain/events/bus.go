@@ -0,0 +1,43 @@
+package events
+
+import (
+	"sync"
+)
+
+// Handler processa um evento de domínio.
+type Handler func(Event)
+
+// Bus é um barramento de eventos síncrono e em memória.
+//
+// Os handlers de um tipo de evento são executados na ordem em que foram
+// registrados, na mesma goroutine que chamou Publish.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus cria uma nova instância de Bus.
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe registra um handler para o tipo de evento informado.
+func (b *Bus) Subscribe(eventType string, handler func(Event)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish entrega o evento a todos os handlers registrados para o seu tipo.
+func (b *Bus) Publish(event Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
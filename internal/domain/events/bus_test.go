@@ -0,0 +1,39 @@
+package events
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBusPublishOrder(t *testing.T) {
+	bus := NewBus()
+
+	var calls []string
+	record := func(name string) func(Event) {
+		return func(Event) { calls = append(calls, name) }
+	}
+
+	bus.Subscribe(TypeAnswerRecorded, record("first"))
+	bus.Subscribe(TypeUserRegistered, record("other"))
+	bus.Subscribe(TypeAnswerRecorded, record("second"))
+	bus.Subscribe(TypeAnswerRecorded, record("third"))
+
+	bus.Publish(AnswerRecorded{At: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)})
+
+	if want := []string{"first", "second", "third"}; !slices.Equal(calls, want) {
+		t.Errorf("handlers called = %v, want %v", calls, want)
+	}
+}
+
+func TestBusPublishWithoutHandlers(t *testing.T) {
+	bus := NewBus()
+
+	called := false
+	bus.Subscribe(TypeUserRegistered, func(Event) { called = true })
+	bus.Publish(QuizCompleted{UserID: "user"})
+
+	if called {
+		t.Errorf("handler for %q called on %q", TypeUserRegistered, TypeQuizCompleted)
+	}
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"time"
+
+	"educational-reinforcement-platform/internal/domain/model"
+)
+
+// Tipos dos eventos de domínio
+const (
+	TypeAnswerRecorded = "answer.recorded"
+	TypeUserRegistered = "user.registered"
+	TypeQuizCompleted  = "quiz.completed"
+)
+
+// Event representa um evento de domínio.
+type Event interface {
+	Type() string
+	OccurredAt() time.Time
+}
+
+// AnswerRecorded é publicado quando uma resposta é registrada.
+type AnswerRecorded struct {
+	Answer model.Answer
+	At     time.Time
+}
+
+// Type retorna o tipo do evento
+func (e AnswerRecorded) Type() string { return TypeAnswerRecorded }
+
+// OccurredAt retorna o momento em que o evento ocorreu
+func (e AnswerRecorded) OccurredAt() time.Time { return e.At }
+
+// UserRegistered é publicado quando um usuário se cadastra.
+type UserRegistered struct {
+	User model.User
+	At   time.Time
+}
+
+// Type retorna o tipo do evento
+func (e UserRegistered) Type() string { return TypeUserRegistered }
+
+// OccurredAt retorna o momento em que o evento ocorreu
+func (e UserRegistered) OccurredAt() time.Time { return e.At }
+
+// QuizCompleted é publicado quando um usuário conclui um quiz.
+type QuizCompleted struct {
+	UserID  string
+	Answers []model.Answer
+	At      time.Time
+}
+
+// Type retorna o tipo do evento
+func (e QuizCompleted) Type() string { return TypeQuizCompleted }
+
+// OccurredAt retorna o momento em que o evento ocorreu
+func (e QuizCompleted) OccurredAt() time.Time { return e.At }
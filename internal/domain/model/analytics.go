@@ -0,0 +1,127 @@
+package model
+
+import (
+	"time"
+)
+
+// OptionSelectionCounts conta quantas vezes cada opção da pergunta foi
+// escolhida, considerando apenas as respostas da pergunta informada.
+//
+// Todas as opções da pergunta aparecem no resultado, inclusive as nunca
+// escolhidas.
+func OptionSelectionCounts(q *Question, answers []Answer) map[string]int {
+	counts := make(map[string]int, len(q.Options))
+	for _, opt := range q.Options {
+		counts[opt.ID] = 0
+	}
+
+	for i := range answers {
+		if answers[i].QuestionID != q.ID || answers[i].Invalidated {
+			continue
+		}
+		if _, ok := counts[answers[i].OptionID]; ok {
+			counts[answers[i].OptionID]++
+		}
+	}
+	return counts
+}
+
+// DistractorEffectiveness calcula, para cada opção incorreta da pergunta, a
+// fração (0.0 a 1.0) das respostas da pergunta que a escolheram.
+//
+// Retorna 0 para todas as opções quando a pergunta não tem respostas.
+func DistractorEffectiveness(q *Question, answers []Answer) map[string]float64 {
+	counts := OptionSelectionCounts(q, answers)
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	effectiveness := make(map[string]float64)
+	for _, opt := range q.Options {
+		if opt.IsCorrect {
+			continue
+		}
+		if total == 0 {
+			effectiveness[opt.ID] = 0.0
+			continue
+		}
+		effectiveness[opt.ID] = float64(counts[opt.ID]) / float64(total)
+	}
+	return effectiveness
+}
+
+// HighLowGroups agrupa as respostas dos usuários de melhor e de pior
+// desempenho geral, usadas no índice de discriminação.
+//
+// Pela convenção clássica, High contém as respostas dos 27% com maior
+// pontuação e Low as dos 27% com menor pontuação.
+type HighLowGroups struct {
+	High []Answer
+	Low  []Answer
+}
+
+// DiscriminationIndex calcula o índice de discriminação da pergunta: a
+// proporção de acertos no grupo High menos a proporção de acertos no grupo
+// Low, considerando apenas as respostas da pergunta. O resultado vai de -1 a 1.
+//
+// Retorna 0 quando algum dos grupos não tem respostas para a pergunta.
+func DiscriminationIndex(q *Question, groups HighLowGroups) float64 {
+	high, highOK := proportionCorrect(q.ID, groups.High)
+	low, lowOK := proportionCorrect(q.ID, groups.Low)
+	if !highOK || !lowOK {
+		return 0.0
+	}
+	return high - low
+}
+
+// proportionCorrect calcula a proporção de acertos nas respostas da pergunta.
+//
+// Retorna false quando não há respostas da pergunta.
+func proportionCorrect(questionID string, answers []Answer) (float64, bool) {
+	var total, correct int
+	for i := range answers {
+		if answers[i].QuestionID != questionID || answers[i].Invalidated {
+			continue
+		}
+		total++
+		if answers[i].IsCorrect {
+			correct++
+		}
+	}
+
+	if total == 0 {
+		return 0.0, false
+	}
+	return float64(correct) / float64(total), true
+}
+
+// AverageTimeByDifficulty calcula o tempo médio de resposta por nível de
+// dificuldade da pergunta respondida.
+//
+// Respostas sem tempo medido, invalidadas ou de perguntas não informadas são
+// ignoradas. Retorna um mapa vazio quando não há dados.
+func AverageTimeByDifficulty(answers []Answer, questions map[string]*Question) map[Difficulty]time.Duration {
+	totals := make(map[Difficulty]time.Duration)
+	counts := make(map[Difficulty]int)
+	for i := range answers {
+		if answers[i].TimeTakenMs <= 0 || answers[i].Invalidated {
+			continue
+		}
+
+		q, ok := questions[answers[i].QuestionID]
+		if !ok {
+			continue
+		}
+
+		totals[q.Difficulty] += answers[i].Duration()
+		counts[q.Difficulty]++
+	}
+
+	averages := make(map[Difficulty]time.Duration, len(totals))
+	for difficulty, total := range totals {
+		averages[difficulty] = total / time.Duration(counts[difficulty])
+	}
+	return averages
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"maps"
+	"testing"
+	"time"
+)
+
+// chooseOption cria uma resposta à pergunta escolhendo a opção informada.
+func chooseOption(q *Question, optionID string) Answer {
+	option, _ := q.FindOption(optionID)
+	return Answer{QuestionID: q.ID, OptionID: optionID, IsCorrect: option != nil && option.IsCorrect}
+}
+
+func TestOptionSelectionCounts(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24", "12")
+	otherQuestion := chooseOption(q, testID(1002))
+	otherQuestion.QuestionID = testID(2000)
+	invalidated := chooseOption(q, testID(1002))
+	invalidated.Invalidated = true
+
+	answers := []Answer{
+		chooseOption(q, testID(1001)),
+		chooseOption(q, testID(1002)),
+		chooseOption(q, testID(1002)),
+		chooseOption(q, testID(1001)),
+		chooseOption(q, testID(1001)),
+		chooseOption(q, testID(9999)),
+		otherQuestion,
+		invalidated,
+	}
+
+	wantCounts := map[string]int{testID(1001): 3, testID(1002): 2, testID(1003): 0}
+	if got := OptionSelectionCounts(q, answers); !maps.Equal(got, wantCounts) {
+		t.Errorf("OptionSelectionCounts() = %v, want %v", got, wantCounts)
+	}
+
+	wantEffectiveness := map[string]float64{testID(1002): 0.4, testID(1003): 0}
+	if got := DistractorEffectiveness(q, answers); !maps.Equal(got, wantEffectiveness) {
+		t.Errorf("DistractorEffectiveness() = %v, want %v", got, wantEffectiveness)
+	}
+
+	wantEmpty := map[string]float64{testID(1002): 0, testID(1003): 0}
+	if got := DistractorEffectiveness(q, nil); !maps.Equal(got, wantEmpty) {
+		t.Errorf("DistractorEffectiveness(nil) = %v, want %v", got, wantEmpty)
+	}
+}
+
+func TestDiscriminationIndex(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24", "12")
+	correct := chooseOption(q, testID(1001))
+	wrong := chooseOption(q, testID(1002))
+	otherQuestion := Answer{QuestionID: testID(2000), IsCorrect: true}
+
+	tests := []struct {
+		name   string
+		groups HighLowGroups
+		want   float64
+	}{
+		{
+			name:   "discriminating question",
+			groups: HighLowGroups{High: []Answer{correct, correct, correct, wrong}, Low: []Answer{correct, wrong, wrong, wrong}},
+			want:   0.5,
+		},
+		{
+			name:   "negative discrimination",
+			groups: HighLowGroups{High: []Answer{wrong, wrong}, Low: []Answer{correct, correct}},
+			want:   -1,
+		},
+		{
+			name:   "answers of other questions are ignored",
+			groups: HighLowGroups{High: []Answer{correct, otherQuestion}, Low: []Answer{wrong, otherQuestion}},
+			want:   1,
+		},
+		{
+			name:   "empty low group",
+			groups: HighLowGroups{High: []Answer{correct}, Low: []Answer{otherQuestion}},
+		},
+		{
+			name:   "empty high group",
+			groups: HighLowGroups{Low: []Answer{wrong}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiscriminationIndex(q, tt.groups); got != tt.want {
+				t.Errorf("DiscriminationIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageTimeByDifficulty(t *testing.T) {
+	easy := newTestQuestion(t, Easy, "42", "24")
+	hard := renumberQuestion(newTestQuestion(t, Hard, "42", "24", "12", "6"), 3000)
+	questions := map[string]*Question{easy.ID: easy, hard.ID: hard}
+
+	timed := func(q *Question, ms int64, invalidated bool) Answer {
+		a := chooseOption(q, q.Options[0].ID)
+		a.TimeTakenMs = ms
+		a.Invalidated = invalidated
+		return a
+	}
+	unknown := timed(easy, 9000, false)
+	unknown.QuestionID = testID(4000)
+
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    map[Difficulty]time.Duration
+	}{
+		{name: "empty", want: map[Difficulty]time.Duration{}},
+		{
+			name:    "two difficulties",
+			answers: []Answer{timed(easy, 2000, false), timed(easy, 4000, false), timed(hard, 10000, false)},
+			want:    map[Difficulty]time.Duration{Easy: 3 * time.Second, Hard: 10 * time.Second},
+		},
+		{
+			name: "skipped answers",
+			answers: []Answer{
+				timed(easy, 2000, false), timed(easy, 0, false), timed(easy, 60000, true),
+				timed(hard, 7500, false), unknown,
+			},
+			want: map[Difficulty]time.Duration{Easy: 2 * time.Second, Hard: 7500 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AverageTimeByDifficulty(tt.answers, questions); !maps.Equal(got, tt.want) {
+				t.Errorf("AverageTimeByDifficulty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,41 +2,52 @@ package model
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 )
 
 // Erros específicos do modelo Answer
 var (
-	ErrAnswerIDEmpty = errors.New("answer ID cannot be empty")
+	ErrAnswerIDEmpty    = newDomainError("ANSWER_ID_EMPTY", "answer ID cannot be empty")
+	ErrInvalidTimeTaken = newDomainError("INVALID_TIME_TAKEN", "time taken must be zero or positive")
+	ErrQuestionMismatch = newDomainError("QUESTION_MISMATCH", "answer does not belong to the question")
+	ErrInvalidAttempt   = newDomainError("INVALID_ATTEMPT", "attempt must be zero or positive")
 )
 
-// Answer representa uma resposta a uma pergunta
+// Answer representa uma resposta a uma pergunta.
+//
+// Respostas invalidadas (ex.: de uma pergunta retirada por erro de conteúdo)
+// são mantidas para histórico, mas ignoradas nos cálculos de precisão e nas
+// estatísticas.
 type Answer struct {
-	ID         string    `json:"id"`
-	UserID     string    `json:"userId"`
-	QuestionID string    `json:"questionId"`
-	OptionID   string    `json:"optionId"`
-	IsCorrect  bool      `json:"isCorrect"`
-	CreatedAt  time.Time `json:"createdAt"`
-	UpdatedAt  time.Time `json:"updatedAt"`
+	ID          string    `json:"id"`
+	UserID      string    `json:"userId"`
+	QuestionID  string    `json:"questionId"`
+	OptionID    string    `json:"optionId"`
+	IsCorrect   bool      `json:"isCorrect"`
+	TimeTakenMs int64     `json:"timeTakenMs"`
+	Attempt     int       `json:"attempt"`
+	Invalidated bool      `json:"invalidated"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
 // NewAnswer cria uma nova instância de Answer.
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func NewAnswer(id, userID, questionID, optionID string, isCorrect bool) (*Answer, error) {
-	now := time.Now()
+	timestamp := now()
 	answer := &Answer{
 		ID:         id,
 		UserID:     userID,
 		QuestionID: questionID,
 		OptionID:   optionID,
 		IsCorrect:  isCorrect,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		Attempt:    1,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
 	}
 
 	if err := answer.Validate(); err != nil {
@@ -49,10 +60,21 @@ func NewAnswer(id, userID, questionID, optionID string, isCorrect bool) (*Answer
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func (a *Answer) Validate() error {
+	return a.ValidateCtx(DefaultValidationContext)
+}
+
+// ValidateCtx verifica se os dados da resposta são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (a *Answer) ValidateCtx(ctx ValidationContext) error {
 	ve := &ValidationError{}
 
 	if strings.TrimSpace(a.ID) == "" {
 		ve.Add(ErrAnswerIDEmpty)
+	} else if err := ctx.validateID(a.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if strings.TrimSpace(a.UserID) == "" {
@@ -67,12 +89,203 @@ func (a *Answer) Validate() error {
 		ve.Add(ErrOptionIDEmpty)
 	}
 
+	if a.TimeTakenMs < 0 {
+		ve.Add(ErrInvalidTimeTaken)
+	}
+
+	if a.Attempt < 0 {
+		ve.Add(ErrInvalidAttempt)
+	}
+
 	if ve.HasErrors() {
-		return ve
+		return ctx.localize(ve)
+	}
+	return nil
+}
+
+// ValidateAgainst verifica se a resposta pertence à pergunta informada e se a
+// opção escolhida é uma das opções da pergunta.
+//
+// Use no momento do envio, quando a pergunta está disponível; caso contrário,
+// use Validate.
+//
+// Em caso de erro retorna ErrQuestionMismatch ou ErrOptionNotFound.
+func (a *Answer) ValidateAgainst(q *Question) error {
+	if a.QuestionID != q.ID {
+		return ErrQuestionMismatch
+	}
+
+	if _, found := q.FindOption(a.OptionID); !found {
+		return ErrOptionNotFound
 	}
 	return nil
 }
 
+// IsFirstAttempt verifica se a resposta é a primeira tentativa do usuário na
+// pergunta. Attempt zero indica que a tentativa não foi registrada e é tratado
+// como primeira tentativa.
+func (a *Answer) IsFirstAttempt() bool {
+	return a.Attempt <= 1
+}
+
+// FirstTryAccuracy calcula a precisão (0 a 100) considerando apenas as
+// respostas dadas na primeira tentativa.
+//
+// Retorna 0 quando não há respostas de primeira tentativa.
+func FirstTryAccuracy(answers []Answer) float64 {
+	var total, correct int
+	for i := range answers {
+		if answers[i].Invalidated || !answers[i].IsFirstAttempt() {
+			continue
+		}
+		total++
+		if answers[i].IsCorrect {
+			correct++
+		}
+	}
+
+	if total == 0 {
+		return 0.0
+	}
+	return (float64(correct) / float64(total)) * 100
+}
+
+// Duration retorna o tempo que o usuário levou para responder.
+//
+// Um valor zero indica que o tempo não foi medido.
+func (a *Answer) Duration() time.Duration {
+	return time.Duration(a.TimeTakenMs) * time.Millisecond
+}
+
+// LikelyGuess verifica se a resposta foi rápida demais para não ser um chute,
+// isto é, se Duration() é menor que threshold.
+//
+// Respostas sem tempo medido nunca são consideradas chutes.
+func (a *Answer) LikelyGuess(threshold time.Duration) bool {
+	return a.TimeTakenMs > 0 && a.Duration() < threshold
+}
+
+// GuessRate calcula a proporção (0.0 a 1.0) de respostas com tempo medido que
+// provavelmente foram chutes.
+//
+// Retorna 0 quando nenhuma resposta tem tempo medido.
+func GuessRate(answers []Answer, threshold time.Duration) float64 {
+	var measured, guesses int
+	for i := range answers {
+		if answers[i].Invalidated || answers[i].TimeTakenMs <= 0 {
+			continue
+		}
+		measured++
+		if answers[i].LikelyGuess(threshold) {
+			guesses++
+		}
+	}
+
+	if measured == 0 {
+		return 0.0
+	}
+	return float64(guesses) / float64(measured)
+}
+
+// xpPerPoint é a quantidade de pontos de experiência por ponto de dificuldade.
+const xpPerPoint = 10
+
+// StreakMultipliers define o multiplicador de pontos pela sequência atual de
+// acertos: o índice é o tamanho da sequência e sequências maiores que a
+// tabela usam o último valor.
+var StreakMultipliers = []float64{1.0, 1.1, 1.2, 1.3, 1.4, 1.5}
+
+// AwardPoints calcula os pontos de experiência de uma resposta: os pontos da
+// dificuldade da pergunta (Difficulty.Points() x 10) multiplicados pelo valor
+// de StreakMultipliers para currentStreak.
+//
+// Retorna 0 para respostas incorretas ou que não pertencem à pergunta.
+func AwardPoints(answer Answer, question Question, currentStreak int) int {
+	if !answer.IsCorrect || answer.Invalidated || answer.QuestionID != question.ID {
+		return 0
+	}
+
+	multiplier := 1.0
+	if len(StreakMultipliers) > 0 {
+		step := min(max(currentStreak, 0), len(StreakMultipliers)-1)
+		multiplier = StreakMultipliers[step]
+	}
+
+	base := question.Difficulty.Points() * xpPerPoint
+	return int(math.Round(float64(base) * multiplier))
+}
+
+// PartialScore calcula a pontuação (0.0 a 1.0) de uma seleção de opções: a
+// fração das opções corretas selecionadas menos a fração das incorretas
+// selecionadas, com mínimo 0. Opções marcadas como false em selected são
+// ignoradas.
+//
+// Em perguntas de escolha única a pontuação é 1 quando apenas a opção correta
+// foi selecionada e 0 caso contrário.
+func PartialScore(selected map[string]bool, q *Question) float64 {
+	var totalCorrect, totalIncorrect, hitCorrect, hitIncorrect int
+	for _, opt := range q.Options {
+		if opt.IsCorrect {
+			totalCorrect++
+			if selected[opt.ID] {
+				hitCorrect++
+			}
+		} else {
+			totalIncorrect++
+			if selected[opt.ID] {
+				hitIncorrect++
+			}
+		}
+	}
+
+	if !q.IsMultipleChoice() {
+		if hitCorrect == totalCorrect && hitCorrect > 0 && hitIncorrect == 0 {
+			return 1.0
+		}
+		return 0.0
+	}
+
+	score := 0.0
+	if totalCorrect > 0 {
+		score += float64(hitCorrect) / float64(totalCorrect)
+	}
+	if totalIncorrect > 0 {
+		score -= float64(hitIncorrect) / float64(totalIncorrect)
+	}
+	return max(score, 0.0)
+}
+
+// InvalidateAnswersForQuestion marca como invalidadas as respostas da
+// pergunta informada, sem removê-las.
+//
+// Retorna a quantidade de respostas invalidadas; respostas já invalidadas não
+// são contadas.
+func InvalidateAnswersForQuestion(answers []Answer, questionID string) int {
+	timestamp := now()
+	invalidated := 0
+	for i := range answers {
+		if answers[i].QuestionID != questionID || answers[i].Invalidated {
+			continue
+		}
+		answers[i].Invalidated = true
+		answers[i].UpdatedAt = timestamp
+		invalidated++
+	}
+	return invalidated
+}
+
+// FindOrphanedAnswers retorna as respostas cujo QuestionID não está no
+// conjunto de perguntas conhecidas.
+func FindOrphanedAnswers(answers []Answer, questionIDs map[string]bool) []Answer {
+	var orphaned []Answer
+	for _, a := range answers {
+		if !questionIDs[a.QuestionID] {
+			orphaned = append(orphaned, a)
+		}
+	}
+	return orphaned
+}
+
 // String retorna uma representação em JSON da resposta.
 //
 // Em caso de erro retorna uma string de erro.
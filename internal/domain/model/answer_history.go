@@ -0,0 +1,74 @@
+package model
+
+import (
+	"slices"
+)
+
+// AnswerHistory representa as respostas de um usuário a uma pergunta, em
+// ordem cronológica.
+type AnswerHistory struct {
+	UserID     string   `json:"userId"`
+	QuestionID string   `json:"questionId"`
+	Answers    []Answer `json:"answers"`
+}
+
+// HistoryKey retorna a chave de um AnswerHistory em BuildHistories: o ID do
+// usuário, uma barra e o ID da pergunta.
+func HistoryKey(userID, questionID string) string {
+	return userID + "/" + questionID
+}
+
+// BuildHistories agrupa as respostas por usuário e pergunta, indexadas por
+// HistoryKey. As respostas de cada histórico são ordenadas por CreatedAt e,
+// em caso de empate, por Attempt. Respostas invalidadas são ignoradas.
+func BuildHistories(answers []Answer) map[string]*AnswerHistory {
+	histories := make(map[string]*AnswerHistory)
+	for _, a := range answers {
+		if a.Invalidated {
+			continue
+		}
+
+		key := HistoryKey(a.UserID, a.QuestionID)
+		history, ok := histories[key]
+		if !ok {
+			history = &AnswerHistory{UserID: a.UserID, QuestionID: a.QuestionID}
+			histories[key] = history
+		}
+		history.Answers = append(history.Answers, a)
+	}
+
+	for _, history := range histories {
+		slices.SortStableFunc(history.Answers, func(a, b Answer) int {
+			if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+				return c
+			}
+			return a.Attempt - b.Attempt
+		})
+	}
+	return histories
+}
+
+// LatestCorrect verifica se a resposta mais recente está correta.
+//
+// Retorna falso para um histórico vazio.
+func (h *AnswerHistory) LatestCorrect() bool {
+	if len(h.Answers) == 0 {
+		return false
+	}
+	return h.Answers[len(h.Answers)-1].IsCorrect
+}
+
+// FirstTryCorrect verifica se a primeira resposta do histórico está correta.
+//
+// Retorna falso para um histórico vazio.
+func (h *AnswerHistory) FirstTryCorrect() bool {
+	if len(h.Answers) == 0 {
+		return false
+	}
+	return h.Answers[0].IsCorrect
+}
+
+// AttemptCount retorna a quantidade de respostas do histórico.
+func (h *AnswerHistory) AttemptCount() int {
+	return len(h.Answers)
+}
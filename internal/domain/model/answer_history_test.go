@@ -0,0 +1,112 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// newTestAnswer cria uma resposta do usuário à pergunta informada no instante
+// base acrescido de offset.
+func newTestAnswer(n int, userID, questionID string, isCorrect bool, offset time.Duration) Answer {
+	createdAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC).Add(offset)
+	return Answer{
+		ID:         testID(n),
+		UserID:     userID,
+		QuestionID: questionID,
+		OptionID:   testID(n + 100),
+		IsCorrect:  isCorrect,
+		Attempt:    1,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+	}
+}
+
+func TestBuildHistoriesSkipsInvalidated(t *testing.T) {
+	user, question := testID(1), testID(2)
+
+	first := newTestAnswer(10, user, question, false, 0)
+	first.Invalidated = true
+	second := newTestAnswer(11, user, question, true, time.Minute)
+	retracted := newTestAnswer(12, user, testID(3), true, 0)
+	retracted.Invalidated = true
+
+	histories := BuildHistories([]Answer{second, first, retracted})
+
+	if len(histories) != 1 {
+		t.Fatalf("len(BuildHistories()) = %d, want 1", len(histories))
+	}
+	history := histories[HistoryKey(user, question)]
+	if history == nil {
+		t.Fatalf("missing history for %q", HistoryKey(user, question))
+	}
+	if got := history.AttemptCount(); got != 1 {
+		t.Errorf("AttemptCount() = %d, want 1", got)
+	}
+	if !history.FirstTryCorrect() {
+		t.Errorf("FirstTryCorrect() = false, want true")
+	}
+}
+
+func TestBuildHistoriesMultipleAttempts(t *testing.T) {
+	user, other, question := testID(1), testID(2), testID(3)
+
+	attempt := func(n, attemptNumber int, userID string, isCorrect bool, offset time.Duration) Answer {
+		a := newTestAnswer(n, userID, question, isCorrect, offset)
+		a.Attempt = attemptNumber
+		return a
+	}
+
+	third := attempt(12, 3, user, true, 2*time.Minute)
+	first := attempt(10, 1, user, false, 0)
+	secondSameInstant := attempt(11, 2, user, false, 0)
+	otherUser := attempt(20, 1, other, true, 0)
+
+	histories := BuildHistories([]Answer{third, otherUser, secondSameInstant, first})
+
+	tests := []struct {
+		name                string
+		userID              string
+		wantIDs             []string
+		wantLatestCorrect   bool
+		wantFirstTryCorrect bool
+	}{
+		{name: "retried until correct", userID: user, wantIDs: []string{testID(10), testID(11), testID(12)}, wantLatestCorrect: true},
+		{name: "correct on first try", userID: other, wantIDs: []string{testID(20)}, wantLatestCorrect: true, wantFirstTryCorrect: true},
+	}
+
+	if len(histories) != len(tests) {
+		t.Fatalf("len(BuildHistories()) = %d, want %d", len(histories), len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := histories[HistoryKey(tt.userID, question)]
+			if history == nil {
+				t.Fatalf("missing history for %q", HistoryKey(tt.userID, question))
+			}
+
+			var ids []string
+			for _, a := range history.Answers {
+				ids = append(ids, a.ID)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("Answers = %v, want %v", ids, tt.wantIDs)
+			}
+			if got := history.AttemptCount(); got != len(tt.wantIDs) {
+				t.Errorf("AttemptCount() = %d, want %d", got, len(tt.wantIDs))
+			}
+			if got := history.LatestCorrect(); got != tt.wantLatestCorrect {
+				t.Errorf("LatestCorrect() = %v, want %v", got, tt.wantLatestCorrect)
+			}
+			if got := history.FirstTryCorrect(); got != tt.wantFirstTryCorrect {
+				t.Errorf("FirstTryCorrect() = %v, want %v", got, tt.wantFirstTryCorrect)
+			}
+		})
+	}
+
+	var empty AnswerHistory
+	if empty.LatestCorrect() || empty.FirstTryCorrect() || empty.AttemptCount() != 0 {
+		t.Errorf("empty AnswerHistory = %v, %v, %d, want false, false, 0", empty.LatestCorrect(), empty.FirstTryCorrect(), empty.AttemptCount())
+	}
+}
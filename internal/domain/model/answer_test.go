@@ -0,0 +1,265 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAnswerLikelyGuess(t *testing.T) {
+	threshold := 2 * time.Second
+
+	tests := []struct {
+		name        string
+		timeTakenMs int64
+		want        bool
+	}{
+		{name: "just below the threshold", timeTakenMs: 1999, want: true},
+		{name: "exactly at the threshold", timeTakenMs: 2000},
+		{name: "above the threshold", timeTakenMs: 2001},
+		{name: "not measured", timeTakenMs: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Answer{TimeTakenMs: tt.timeTakenMs}
+			if got := a.LikelyGuess(threshold); got != tt.want {
+				t.Errorf("LikelyGuess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuessRate(t *testing.T) {
+	threshold := 2 * time.Second
+	timed := func(ms int64, invalidated bool) Answer {
+		return Answer{TimeTakenMs: ms, Invalidated: invalidated}
+	}
+
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    float64
+	}{
+		{name: "half guesses", answers: []Answer{timed(500, false), timed(1999, false), timed(2000, false), timed(9000, false)}, want: 0.5},
+		{name: "unmeasured answers are ignored", answers: []Answer{timed(500, false), timed(0, false)}, want: 1},
+		{name: "invalidated answers are ignored", answers: []Answer{timed(500, true), timed(3000, false)}, want: 0},
+		{name: "nothing measured", answers: []Answer{timed(0, false)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GuessRate(tt.answers, threshold); got != tt.want {
+				t.Errorf("GuessRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerValidateAgainst(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+
+	tests := []struct {
+		name       string
+		questionID string
+		optionID   string
+		want       error
+	}{
+		{name: "valid", questionID: q.ID, optionID: testID(1002)},
+		{name: "other question", questionID: testID(2000), optionID: testID(1002), want: ErrQuestionMismatch},
+		{name: "option from another question", questionID: q.ID, optionID: testID(3001), want: ErrOptionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Answer{ID: testID(1), UserID: testID(2), QuestionID: tt.questionID, OptionID: tt.optionID}
+			if err := a.ValidateAgainst(q); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateAgainst() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstTryAccuracy(t *testing.T) {
+	attempt := func(n int, isCorrect, invalidated bool) Answer {
+		return Answer{Attempt: n, IsCorrect: isCorrect, Invalidated: invalidated}
+	}
+
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    float64
+	}{
+		{name: "empty"},
+		{name: "retries are ignored", answers: []Answer{attempt(1, false, false), attempt(2, true, false), attempt(1, true, false)}, want: 50},
+		{name: "unrecorded attempt counts as first", answers: []Answer{attempt(0, true, false), attempt(1, false, false)}, want: 50},
+		{name: "invalidated answers are ignored", answers: []Answer{attempt(1, true, false), attempt(1, false, true)}, want: 100},
+		{name: "only retries", answers: []Answer{attempt(2, true, false), attempt(3, true, false)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstTryAccuracy(tt.answers); got != tt.want {
+				t.Errorf("FirstTryAccuracy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAwardPoints(t *testing.T) {
+	question := Question{ID: testID(1000), Difficulty: Medium}
+	correct := Answer{QuestionID: question.ID, IsCorrect: true}
+
+	tests := []struct {
+		name   string
+		answer Answer
+		streak int
+		want   int
+	}{
+		{name: "streak 0", answer: correct, streak: 0, want: 30},
+		{name: "negative streak", answer: correct, streak: -2, want: 30},
+		{name: "mid streak", answer: correct, streak: 3, want: 39},
+		{name: "at cap", answer: correct, streak: 5, want: 45},
+		{name: "beyond cap", answer: correct, streak: 50, want: 45},
+		{name: "incorrect", answer: Answer{QuestionID: question.ID}, streak: 3},
+		{name: "invalidated", answer: Answer{QuestionID: question.ID, IsCorrect: true, Invalidated: true}, streak: 3},
+		{name: "other question", answer: Answer{QuestionID: testID(2000), IsCorrect: true}, streak: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AwardPoints(tt.answer, question, tt.streak); got != tt.want {
+				t.Errorf("AwardPoints() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPartialScore(t *testing.T) {
+	single := newTestQuestion(t, Medium, "42", "24", "12")
+	multiple := &Question{
+		ID:   testID(1000),
+		Type: QuestionTypeMultipleChoice,
+		Options: []Option{
+			{ID: testID(1001), IsCorrect: true},
+			{ID: testID(1002), IsCorrect: true},
+			{ID: testID(1003)},
+			{ID: testID(1004)},
+		},
+	}
+	selection := func(ns ...int) map[string]bool {
+		selected := make(map[string]bool, len(ns))
+		for _, n := range ns {
+			selected[testID(n)] = true
+		}
+		return selected
+	}
+
+	tests := []struct {
+		name     string
+		question *Question
+		selected map[string]bool
+		want     float64
+	}{
+		{name: "multiple: all correct", question: multiple, selected: selection(1001, 1002), want: 1},
+		{name: "multiple: some correct", question: multiple, selected: selection(1001), want: 0.5},
+		{name: "multiple: all correct and one wrong", question: multiple, selected: selection(1001, 1002, 1003), want: 0.5},
+		{name: "multiple: some correct and one wrong", question: multiple, selected: selection(1001, 1003), want: 0},
+		{name: "multiple: all wrong", question: multiple, selected: selection(1003, 1004), want: 0},
+		{name: "multiple: false entries ignored", question: multiple, selected: map[string]bool{testID(1001): true, testID(1002): true, testID(1003): false}, want: 1},
+		{name: "single: correct", question: single, selected: selection(1001), want: 1},
+		{name: "single: correct and one wrong", question: single, selected: selection(1001, 1002), want: 0},
+		{name: "single: wrong", question: single, selected: selection(1003), want: 0},
+		{name: "nothing selected", question: multiple, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PartialScore(tt.selected, tt.question); got != tt.want {
+				t.Errorf("PartialScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindOrphanedAnswers(t *testing.T) {
+	known := map[string]bool{testID(1000): true}
+	answers := []Answer{
+		newTestAnswer(1, testID(1), testID(1000), true, 0),
+		newTestAnswer(2, testID(1), testID(9000), false, 0),
+		newTestAnswer(3, testID(1), testID(1000), false, 0),
+		newTestAnswer(4, testID(1), testID(9001), true, 0),
+	}
+
+	var got []string
+	for _, a := range FindOrphanedAnswers(answers, known) {
+		got = append(got, a.ID)
+	}
+	if want := []string{testID(2), testID(4)}; !slices.Equal(got, want) {
+		t.Errorf("FindOrphanedAnswers() = %v, want %v", got, want)
+	}
+
+	if got := FindOrphanedAnswers(answers[:1], known); got != nil {
+		t.Errorf("FindOrphanedAnswers(valid) = %v, want nil", got)
+	}
+}
+
+func TestInvalidateAnswersForQuestion(t *testing.T) {
+	at := time.Date(2024, time.April, 1, 9, 0, 0, 0, time.UTC)
+	useFakeClock(t, at)
+
+	user, flawed, sound := testID(1), testID(2), testID(3)
+
+	tests := []struct {
+		name         string
+		questionID   string
+		wantCount    int
+		wantAccuracy float64
+	}{
+		{name: "flawed question", questionID: flawed, wantCount: 2, wantAccuracy: 100},
+		{name: "sound question", questionID: sound, wantCount: 2, wantAccuracy: 0},
+		{name: "unknown question", questionID: testID(4), wantAccuracy: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answers := []Answer{
+				newTestAnswer(10, user, flawed, false, 0),
+				newTestAnswer(11, user, flawed, false, time.Minute),
+				newTestAnswer(12, user, sound, true, 2*time.Minute),
+				newTestAnswer(13, user, sound, true, 3*time.Minute),
+			}
+
+			if got := InvalidateAnswersForQuestion(answers, tt.questionID); got != tt.wantCount {
+				t.Fatalf("InvalidateAnswersForQuestion() = %d, want %d", got, tt.wantCount)
+			}
+			if got := InvalidateAnswersForQuestion(answers, tt.questionID); got != 0 {
+				t.Errorf("InvalidateAnswersForQuestion() again = %d, want 0", got)
+			}
+
+			for _, a := range answers {
+				if a.Invalidated != (a.QuestionID == tt.questionID) {
+					t.Errorf("answer %s Invalidated = %v", a.ID, a.Invalidated)
+				}
+				if a.Invalidated && !a.UpdatedAt.Equal(at) {
+					t.Errorf("answer %s UpdatedAt = %v, want %v", a.ID, a.UpdatedAt, at)
+				}
+			}
+
+			if got := FirstTryAccuracy(answers); got != tt.wantAccuracy {
+				t.Errorf("FirstTryAccuracy() = %v, want %v", got, tt.wantAccuracy)
+			}
+
+			perf := newTestPerformance(20, 0, 0, at)
+			for _, a := range answers {
+				if err := perf.ApplyAnswer(a); err != nil {
+					t.Fatalf("ApplyAnswer() error = %v", err)
+				}
+			}
+			if got := perf.GetAccuracy(); got != tt.wantAccuracy {
+				t.Errorf("GetAccuracy() = %v, want %v", got, tt.wantAccuracy)
+			}
+		})
+	}
+}
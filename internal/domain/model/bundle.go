@@ -0,0 +1,75 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Erros específicos da validação de pacotes de importação
+var (
+	ErrQuestionSubjectMismatch = newDomainError("QUESTION_SUBJECT_MISMATCH", "question does not belong to the subject")
+)
+
+// ValidateBundle verifica um pacote de importação (disciplina e perguntas com
+// suas opções): a disciplina deve existir e ser válida, cada pergunta deve
+// passar por CheckIntegrity e pertencer à disciplina, e os IDs das opções não
+// podem se repetir entre perguntas diferentes (repetições dentro da mesma
+// pergunta são reportadas por CheckIntegrity).
+//
+// Em caso de erro retorna ValidationError que contém todos os erros
+// encontrados, com o contexto de cada um (ex.: "question 2: ...").
+func ValidateBundle(subject *Subject, questions []*Question) error {
+	ve := &ValidationError{}
+
+	if subject == nil {
+		ve.Add(fmt.Errorf("subject: %w", ErrSubjectNotFound))
+	} else {
+		addWithContext(ve, "subject", subject.Validate())
+	}
+
+	seenOptions := make(map[string]int)
+	for i, q := range questions {
+		context := fmt.Sprintf("question %d", i)
+		if q == nil {
+			ve.Add(fmt.Errorf("%s: %w", context, ErrQuestionNotFound))
+			continue
+		}
+
+		addWithContext(ve, context, q.CheckIntegrity())
+
+		if subject != nil && q.SubjectID != subject.ID {
+			ve.Add(fmt.Errorf("%s: %w", context, ErrQuestionSubjectMismatch))
+		}
+
+		for _, opt := range q.Options {
+			first, seen := seenOptions[opt.ID]
+			if !seen {
+				seenOptions[opt.ID] = i
+				continue
+			}
+			if first != i {
+				ve.Add(fmt.Errorf("%s: option %q (also in question %d): %w", context, opt.ID, first, ErrDuplicateOptionID))
+			}
+		}
+	}
+
+	if ve.HasErrors() {
+		return ve
+	}
+	return nil
+}
+
+// addWithContext adiciona err à lista de erros de validação prefixado com o
+// contexto. Se err for um ValidationError, cada erro contido é prefixado.
+func addWithContext(ve *ValidationError, context string, err error) {
+	var inner *ValidationError
+	if errors.As(err, &inner) {
+		for _, e := range inner.Errors {
+			ve.Add(fmt.Errorf("%s: %w", context, e))
+		}
+		return
+	}
+	if err != nil {
+		ve.Add(fmt.Errorf("%s: %w", context, err))
+	}
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateBundle(t *testing.T) {
+	subject := newTestSubject(t, 2000, "Arithmetic")
+	question := func(n int) *Question {
+		return renumberQuestion(newTestQuestion(t, Medium, "42", "24"), n)
+	}
+
+	crossSubject := question(1200)
+	crossSubject.SubjectID = testID(2001)
+	sharedOption := question(1300)
+	sharedOption.Options[1].ID = testID(1001)
+	invalid := question(1400)
+	invalid.Content = ""
+	repeatedOption := question(1500)
+	repeatedOption.Options[1].ID = repeatedOption.Options[0].ID
+	foreignOption := question(1600)
+	foreignOption.Options[1].QuestionID = testID(1000)
+
+	tests := []struct {
+		name      string
+		subject   *Subject
+		questions []*Question
+		wantErrs  []string
+		wantIs    []error
+	}{
+		{name: "valid", subject: subject, questions: []*Question{question(1000), question(1100)}},
+		{
+			name:      "cross-subject question",
+			subject:   subject,
+			questions: []*Question{question(1000), crossSubject},
+			wantErrs:  []string{"question 1: question does not belong to the subject"},
+			wantIs:    []error{ErrQuestionSubjectMismatch},
+		},
+		{
+			name:      "option ID repeated across questions",
+			subject:   subject,
+			questions: []*Question{question(1000), sharedOption},
+			wantErrs:  []string{"question 1: option \"" + testID(1001) + "\" (also in question 0)"},
+			wantIs:    []error{ErrDuplicateOptionID},
+		},
+		{
+			name:      "invalid subject, question and missing question",
+			subject:   &Subject{ID: subject.ID, Name: "x"},
+			questions: []*Question{invalid, nil},
+			wantErrs:  []string{"subject: subject name", "question 0: question content", "question 1: question not found"},
+			wantIs:    []error{ErrInvalidSubjectName, ErrEmptyQuestionContent, ErrQuestionNotFound},
+		},
+		{
+			name:      "missing subject",
+			questions: []*Question{question(1000)},
+			wantErrs:  []string{"subject: subject not found"},
+			wantIs:    []error{ErrSubjectNotFound},
+		},
+		{
+			name:      "option ID repeated within a question is reported once",
+			subject:   subject,
+			questions: []*Question{repeatedOption},
+			wantErrs:  []string{"question 0: option \"" + testID(1501) + "\""},
+			wantIs:    []error{ErrDuplicateOptionID},
+		},
+		{
+			name:      "option referencing another question",
+			subject:   subject,
+			questions: []*Question{foreignOption},
+			wantErrs:  []string{"question 0: option \"" + testID(1602) + "\""},
+			wantIs:    []error{ErrOptionQuestionIDMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBundle(tt.subject, tt.questions)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateBundle() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var ve *ValidationError
+			if !errors.As(err, &ve) || ve.Count() != len(tt.wantErrs) {
+				t.Fatalf("ValidateBundle() error = %v, want %d errors", err, len(tt.wantErrs))
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("ValidateBundle() error = %q, want it to contain %q", err, want)
+				}
+			}
+			for _, want := range tt.wantIs {
+				if !errors.Is(err, want) {
+					t.Errorf("ValidateBundle() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
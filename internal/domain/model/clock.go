@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"educational-reinforcement-platform/pkg/clock"
+)
+
+// modelClock é a fonte de tempo usada pelos modelos. Pode ser substituída em
+// testes com SetClock para congelar ou avançar o tempo.
+var modelClock = clock.Real()
+
+// now retorna o instante atual em UTC, usado em todos os timestamps dos modelos.
+func now() time.Time {
+	return modelClock.Now().UTC()
+}
+
+// SetClock substitui a fonte de tempo dos modelos. Passar nil restaura o
+// relógio do sistema.
+func SetClock(c clock.Clock) {
+	if c == nil {
+		c = clock.Real()
+	}
+	modelClock = c
+}
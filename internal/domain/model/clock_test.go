@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConstructorsUseFrozenUTCClock(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	frozen := time.Date(2024, time.March, 1, 9, 30, 0, 123456789, saoPaulo)
+	useFakeClock(t, frozen)
+
+	user := newTestUser(t, 1, "João Silva", "joao@example.com")
+	subject := newTestSubject(t, 2, "Algebra")
+	question := newTestQuestion(t, Medium, "42", "24")
+	answer, err := NewAnswer(testID(3), user.ID, question.ID, question.Options[0].ID, true)
+	if err != nil {
+		t.Fatalf("NewAnswer() error = %v", err)
+	}
+	perf, err := NewPerformance(testID(4), user.ID, subject.ID, PeriodWeekly, 1, 0)
+	if err != nil {
+		t.Fatalf("NewPerformance() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		timestamps []time.Time
+	}{
+		{name: "user", timestamps: []time.Time{user.CreatedAt, user.UpdatedAt}},
+		{name: "subject", timestamps: []time.Time{subject.CreatedAt, subject.UpdatedAt}},
+		{name: "question", timestamps: []time.Time{question.CreatedAt, question.UpdatedAt}},
+		{name: "option", timestamps: []time.Time{question.Options[0].CreatedAt, question.Options[0].UpdatedAt}},
+		{name: "answer", timestamps: []time.Time{answer.CreatedAt}},
+		{name: "performance", timestamps: []time.Time{perf.CalculatedAt}},
+	}
+
+	want := frozen.UTC()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, ts := range tt.timestamps {
+				if ts != want {
+					t.Errorf("timestamps[%d] = %v, want exactly %v", i, ts, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+// difficultyLevels lista os níveis de dificuldade válidos em ordem crescente.
+var difficultyLevels = []Difficulty{VeryEasy, Easy, Medium, Hard, VeryHard}
+
+// FieldConstraints descreve as regras de validação dos campos de cada modelo,
+// em formato serializável para JSON, para que clientes gerem formulários
+// consistentes com as regras do backend.
+//
+// O mapa retornado é indexado pelo nome do modelo e, em seguida, pelo nome do
+// campo em JSON.
+func FieldConstraints() map[string]any {
+	difficultyLabels := make([]string, 0, len(difficultyLevels))
+	for _, d := range difficultyLevels {
+		difficultyLabels = append(difficultyLabels, d.canonicalLabel())
+	}
+
+	return map[string]any{
+		"user": map[string]any{
+			"id":          map[string]any{"required": true, "format": "uuid"},
+			"name":        map[string]any{"required": true, "minLength": MinUserNameLength},
+			"email":       map[string]any{"required": true, "format": "email", "pattern": emailRegexPattern},
+			"role":        map[string]any{"required": true, "enum": []Role{RoleAdmin, RoleUser}},
+			"difficulty":  map[string]any{"required": true, "enum": difficultyLabels},
+			"status":      map[string]any{"required": true, "enum": []Status{StatusActive, StatusInactive, StatusPending, StatusDeleted}},
+			"lastLoginAt": map[string]any{"format": "date-time"},
+		},
+		"subject": map[string]any{
+			"id":            map[string]any{"required": true, "format": "uuid"},
+			"name":          map[string]any{"required": true, "minLength": MinSubjectNameLength},
+			"questionCount": map[string]any{"minimum": 0},
+			"parentId":      map[string]any{"format": "uuid"},
+		},
+		"question": map[string]any{
+			"id":         map[string]any{"required": true, "format": "uuid"},
+			"subjectId":  map[string]any{"required": true},
+			"content":    map[string]any{"required": true, "maxLength": MaxQuestionContentLength},
+			"difficulty": map[string]any{"required": true, "enum": difficultyLabels},
+			"type":       map[string]any{"enum": []QuestionType{QuestionTypeSingleChoice, QuestionTypeMultipleChoice}},
+			"options": map[string]any{
+				"required": true,
+				"minItems": int(VeryEasy),
+				"maxItems": "difficulty",
+				"correctItems": map[string]any{
+					string(QuestionTypeSingleChoice):   1,
+					string(QuestionTypeMultipleChoice): "1+",
+				},
+			},
+			"mediaUrl":  map[string]any{"format": "uri", "schemes": []string{"http", "https"}, "requiredWith": "mediaType"},
+			"mediaType": map[string]any{"requiredWith": "mediaUrl"},
+			"version":   map[string]any{"required": true, "minimum": 1},
+		},
+		"option": map[string]any{
+			"id":         map[string]any{"required": true, "format": "uuid"},
+			"questionId": map[string]any{"required": true},
+			"content":    map[string]any{"required": true, "maxLength": MaxOptionContentLength},
+			"isCorrect":  map[string]any{"required": true},
+			"pinned":     map[string]any{"maxPerQuestion": 1},
+		},
+		"answer": map[string]any{
+			"id":          map[string]any{"required": true, "format": "uuid"},
+			"userId":      map[string]any{"required": true},
+			"questionId":  map[string]any{"required": true},
+			"optionId":    map[string]any{"required": true},
+			"timeTakenMs": map[string]any{"minimum": 0},
+			"attempt":     map[string]any{"minimum": 0},
+			"invalidated": map[string]any{"default": false},
+		},
+		"performance": map[string]any{
+			"id":              map[string]any{"required": true, "format": "uuid"},
+			"userId":          map[string]any{"required": true},
+			"subjectId":       map[string]any{"required": true},
+			"period":          map[string]any{"required": true, "enum": []Period{PeriodDaily, PeriodWeekly, PeriodMonthly, PeriodYearly}},
+			"correct":         map[string]any{"required": true, "minimum": 0},
+			"incorrect":       map[string]any{"required": true, "minimum": 0},
+			"firstTryCorrect": map[string]any{"minimum": 0, "maximum": "correct"},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFieldConstraints(t *testing.T) {
+	models := map[string]any{
+		"user":        User{},
+		"subject":     Subject{},
+		"question":    Question{},
+		"option":      Option{},
+		"answer":      Answer{},
+		"performance": Performance{},
+	}
+
+	constraints := FieldConstraints()
+	if len(constraints) != len(models) {
+		t.Errorf("FieldConstraints() has %d models, want %d", len(constraints), len(models))
+	}
+
+	for name, model := range models {
+		t.Run(name, func(t *testing.T) {
+			fields, ok := constraints[name].(map[string]any)
+			if !ok {
+				t.Fatalf("FieldConstraints()[%q] is missing", name)
+			}
+
+			tags := make(map[string]bool)
+			typ := reflect.TypeOf(model)
+			for i := 0; i < typ.NumField(); i++ {
+				tag, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+				tags[tag] = true
+			}
+
+			for field := range fields {
+				if !tags[field] {
+					t.Errorf("constraint for %q does not match a JSON field of %s", field, typ.Name())
+				}
+			}
+		})
+	}
+
+	if _, err := json.Marshal(constraints); err != nil {
+		t.Errorf("json.Marshal(FieldConstraints()) error = %v", err)
+	}
+}
@@ -2,14 +2,19 @@ package model
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 )
 
 // Erros específicos do modelo Difficulty
 var (
-	ErrInvalidDifficulty = errors.New("difficulty must be between VeryEasy(2) and VeryHard(6)")
-	ErrChangeDifficulty  = errors.New("current options exceed new difficulty")
+	ErrInvalidDifficulty = newDomainError("INVALID_DIFFICULTY", "difficulty must be between VeryEasy(2) and VeryHard(6)")
+	ErrChangeDifficulty  = newDomainError("CHANGE_DIFFICULTY", "current options exceed new difficulty")
+	ErrDifficultyUnset   = newDomainError("DIFFICULTY_UNSET", "difficulty is not set")
 )
 
 // Difficulty representa os níveis de dificuldade disponíveis.
@@ -25,16 +30,61 @@ const (
 
 // ValidateDifficulty verifica se o nível de dificuldade é válido.
 //
-// Em caso de erro retorna ErrInvalidDifficulty.
+// O valor zero indica uma dificuldade não informada (ex.: campo esquecido em
+// uma struct literal) e é reportado separadamente dos valores fora da faixa.
+//
+// Em caso de erro retorna ErrDifficultyUnset ou ErrInvalidDifficulty.
 func validateDifficulty(difficulty Difficulty) error {
+	if difficulty == 0 {
+		return ErrDifficultyUnset
+	}
 	if difficulty < VeryEasy || difficulty > VeryHard {
 		return ErrInvalidDifficulty
 	}
 	return nil
 }
 
-// String retorna a representação em string do nível de dificuldade.
+// displayLabels define os rótulos de exibição dos níveis de dificuldade
+// (ex.: "Fácil" em vez de "Easy"). Níveis ausentes usam o rótulo canônico em
+// inglês. Altere com SetDifficultyLabels.
+var (
+	displayLabelsMu sync.RWMutex
+	displayLabels   = map[Difficulty]string{}
+)
+
+// SetDifficultyLabels substitui os rótulos de exibição dos níveis de
+// dificuldade por uma cópia de labels. Passar nil restaura os rótulos em inglês.
+//
+// Os rótulos de exibição afetam apenas String: a serialização JSON e
+// ParseDifficulty continuam usando os rótulos canônicos em inglês.
+func SetDifficultyLabels(labels map[Difficulty]string) {
+	copied := make(map[Difficulty]string, len(labels))
+	for difficulty, label := range labels {
+		copied[difficulty] = label
+	}
+
+	displayLabelsMu.Lock()
+	defer displayLabelsMu.Unlock()
+
+	displayLabels = copied
+}
+
+// String retorna o rótulo de exibição do nível de dificuldade, definido com
+// SetDifficultyLabels, ou o rótulo canônico em inglês.
 func (d Difficulty) String() string {
+	displayLabelsMu.RLock()
+	label, ok := displayLabels[d]
+	displayLabelsMu.RUnlock()
+
+	if ok {
+		return label
+	}
+	return d.canonicalLabel()
+}
+
+// canonicalLabel retorna o rótulo canônico em inglês do nível de dificuldade,
+// usado na serialização.
+func (d Difficulty) canonicalLabel() string {
 	switch d {
 	case VeryEasy:
 		return "Very Easy"
@@ -53,40 +103,128 @@ func (d Difficulty) String() string {
 
 // MarshalJSON implementa a interface json.Marshaler para customizar a
 // serialização do nível de dificuldade.
-func (d *Difficulty) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.String())
+//
+// O receptor é por valor para que a serialização use o rótulo mesmo quando a
+// dificuldade está em uma struct não endereçável (ex.: json.Marshal(question)).
+func (d Difficulty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.canonicalLabel())
 }
 
 // UnmarshalJSON implementa a interface json.Unmarshaler para customizar a
 // desserialização do nível de dificuldade.
 //
-// Em caso de erro retorna ErrInvalidDifficulty.
+// Aceita tanto o rótulo (ex.: "Medium", ver ParseDifficulty) quanto o valor
+// numérico (ex.: 4).
+//
+// Em caso de erro retorna ErrDifficultyUnset (para 0) ou ErrInvalidDifficulty.
 func (d *Difficulty) UnmarshalJSON(data []byte) error {
+	var difficultyInt int
+	if err := json.Unmarshal(data, &difficultyInt); err == nil {
+		difficulty, err := FromInt(difficultyInt)
+		if err != nil {
+			return err
+		}
+		*d = difficulty
+		return nil
+	}
+
 	var difficultyStr string
 	if err := json.Unmarshal(data, &difficultyStr); err != nil {
 		return fmt.Errorf("[model.UnmarshalJSON] ERROR: %w", err)
 	}
 
-	var difficulty Difficulty
-	switch difficultyStr {
-	case "Very Easy":
-		difficulty = VeryEasy
-	case "Easy":
-		difficulty = Easy
-	case "Medium":
-		difficulty = Medium
-	case "Hard":
-		difficulty = Hard
-	case "Very Hard":
-		difficulty = VeryHard
-	default:
-		return ErrInvalidDifficulty
+	difficulty, err := ParseDifficulty(difficultyStr)
+	if err != nil {
+		return err
 	}
 
 	*d = difficulty
 	return nil
 }
 
+// MarshalText implementa a interface encoding.TextMarshaler, usada pelo
+// encoding/json nas chaves de mapas (ex.: GradeReport.ByDifficulty), para que
+// sejam serializadas com o rótulo canônico em vez do valor numérico.
+func (d Difficulty) MarshalText() ([]byte, error) {
+	return []byte(d.canonicalLabel()), nil
+}
+
+// UnmarshalText implementa a interface encoding.TextUnmarshaler, aceitando os
+// mesmos formatos de ParseDifficulty.
+//
+// Em caso de erro retorna ErrDifficultyUnset (para "0") ou ErrInvalidDifficulty.
+func (d *Difficulty) UnmarshalText(text []byte) error {
+	difficulty, err := ParseDifficulty(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = difficulty
+	return nil
+}
+
+// ParseDifficulty converte um texto para o nível de dificuldade.
+//
+// Aceita o rótulo (ex.: "Very Easy"), sem diferenciar maiúsculas e minúsculas
+// e ignorando espaços, hífens e sublinhados (ex.: "very-easy", "VERY_EASY"),
+// ou o valor numérico (ex.: "4").
+//
+// Em caso de erro retorna ErrDifficultyUnset (para "0") ou ErrInvalidDifficulty.
+func ParseDifficulty(s string) (Difficulty, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		return FromInt(n)
+	}
+
+	key := normalizeDifficultyLabel(s)
+	for _, difficulty := range difficultyLevels {
+		if normalizeDifficultyLabel(difficulty.canonicalLabel()) == key {
+			return difficulty, nil
+		}
+	}
+	return 0, ErrInvalidDifficulty
+}
+
+// normalizeDifficultyLabel normaliza o rótulo da dificuldade para comparação,
+// removendo espaços, hífens e sublinhados e convertendo para minúsculas.
+func normalizeDifficultyLabel(label string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, label)
+}
+
+// difficultyPoints define a pontuação atribuída a cada nível de dificuldade.
+var difficultyPoints = map[Difficulty]int{
+	VeryEasy: 1,
+	Easy:     2,
+	Medium:   3,
+	Hard:     4,
+	VeryHard: 5,
+}
+
+// Points retorna a pontuação associada ao nível de dificuldade.
+//
+// Retorna 0 para níveis inválidos.
+func (d Difficulty) Points() int {
+	return difficultyPoints[d]
+}
+
+// DifficultyFromPoints retorna o nível de dificuldade cuja pontuação (ver
+// Points) é igual à informada.
+//
+// Em caso de erro retorna ErrInvalidDifficulty.
+func DifficultyFromPoints(points int) (Difficulty, error) {
+	for difficulty, p := range difficultyPoints {
+		if p == points {
+			return difficulty, nil
+		}
+	}
+	return 0, ErrInvalidDifficulty
+}
+
 // ToInt converte o nível de dificuldade para um valor inteiro.
 func (d Difficulty) ToInt() int {
 	return int(d)
@@ -94,7 +232,7 @@ func (d Difficulty) ToInt() int {
 
 // FromInt converte um valor inteiro para o nível de dificuldade correspondente.
 //
-// Em caso de erro retorna ErrInvalidDifficulty.
+// Em caso de erro retorna ErrDifficultyUnset (para 0) ou ErrInvalidDifficulty.
 func FromInt(value int) (Difficulty, error) {
 	difficulty := Difficulty(value)
 	if err := validateDifficulty(difficulty); err != nil {
@@ -102,3 +240,118 @@ func FromInt(value int) (Difficulty, error) {
 	}
 	return difficulty, nil
 }
+
+// MaxOptions retorna a quantidade máxima de opções permitida pelo nível de
+// dificuldade.
+func (d Difficulty) MaxOptions() int {
+	return int(d)
+}
+
+// Next retorna o próximo nível de dificuldade, limitado a VeryHard.
+func (d Difficulty) Next() Difficulty {
+	if d >= VeryHard {
+		return VeryHard
+	}
+	if d < VeryEasy {
+		return VeryEasy
+	}
+	return d + 1
+}
+
+// Previous retorna o nível de dificuldade anterior, limitado a VeryEasy.
+func (d Difficulty) Previous() Difficulty {
+	if d <= VeryEasy {
+		return VeryEasy
+	}
+	if d > VeryHard {
+		return VeryHard
+	}
+	return d - 1
+}
+
+// Limites usados por RecommendDifficulty. Podem ser ajustados por implantação.
+var (
+	// RecommendHighAccuracy é a precisão (0 a 100) acima da qual a dificuldade sobe.
+	RecommendHighAccuracy = 80.0
+	// RecommendLowAccuracy é a precisão (0 a 100) abaixo da qual a dificuldade desce.
+	RecommendLowAccuracy = 50.0
+	// RecommendMinQuestions é a quantidade mínima de respostas para recomendar
+	// uma mudança.
+	RecommendMinQuestions = 10
+)
+
+// RecommendDifficulty recomenda a dificuldade do usuário a partir do
+// desempenho recente: sobe um nível quando a precisão é maior que
+// RecommendHighAccuracy e desce um nível quando é menor que
+// RecommendLowAccuracy.
+//
+// Retorna current quando o desempenho é nulo ou tem menos que
+// RecommendMinQuestions respostas.
+func RecommendDifficulty(current Difficulty, recentPerf *Performance) Difficulty {
+	if recentPerf == nil || recentPerf.GetTotalQuestions() < RecommendMinQuestions {
+		return current
+	}
+
+	accuracy := recentPerf.GetAccuracy()
+	switch {
+	case accuracy > RecommendHighAccuracy:
+		return current.Next()
+	case accuracy < RecommendLowAccuracy:
+		return current.Previous()
+	default:
+		return current
+	}
+}
+
+// SuggestNextDifficulty sugere a dificuldade da próxima pergunta a partir das
+// respostas recentes, ordenadas da mais antiga para a mais recente.
+//
+// Considera apenas a sequência final de respostas com o mesmo resultado: sobe
+// um nível após stepUp acertos consecutivos e desce um nível após stepDown
+// erros consecutivos. Valores menores que 1 desabilitam a respectiva mudança.
+// Respostas invalidadas são ignoradas.
+func SuggestNextDifficulty(current Difficulty, recent []Answer, stepUp, stepDown int) Difficulty {
+	var last bool
+	streak := 0
+	for i := len(recent) - 1; i >= 0; i-- {
+		if recent[i].Invalidated {
+			continue
+		}
+		if streak > 0 && recent[i].IsCorrect != last {
+			break
+		}
+		last = recent[i].IsCorrect
+		streak++
+	}
+
+	if streak == 0 {
+		return current
+	}
+
+	switch {
+	case last && stepUp > 0 && streak >= stepUp:
+		return current.Next()
+	case !last && stepDown > 0 && streak >= stepDown:
+		return current.Previous()
+	default:
+		return current
+	}
+}
+
+// SuggestedTimeLimits define o tempo sugerido para responder a uma pergunta em
+// cada nível de dificuldade. Pode ser ajustada por implantação.
+var SuggestedTimeLimits = map[Difficulty]time.Duration{
+	VeryEasy: 30 * time.Second,
+	Easy:     45 * time.Second,
+	Medium:   60 * time.Second,
+	Hard:     90 * time.Second,
+	VeryHard: 120 * time.Second,
+}
+
+// SuggestedTimeLimit retorna o tempo sugerido para responder a uma pergunta
+// do nível de dificuldade, conforme SuggestedTimeLimits.
+//
+// Retorna 0 para níveis sem tempo definido.
+func (d Difficulty) SuggestedTimeLimit() time.Duration {
+	return SuggestedTimeLimits[d]
+}
@@ -0,0 +1,453 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// difficultySeeds é o corpus inicial dos testes de fuzzing de dificuldade:
+// valores numéricos, rótulos, vazio e entradas inválidas.
+var difficultySeeds = []string{
+	"2", "4", "6", "0", "7", "-1", "99999999999999999999",
+	"Very Easy", "medium", "VERY_HARD", "very-easy", " Hard ",
+	"", "   ",
+	"Unknown", "Médio", "4.5", "0x4", "\x00", "\xff\xfe", "Very  Very Hard",
+}
+
+func TestParseDifficulty(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Difficulty
+		wantErr error
+	}{
+		{input: "4", want: Medium},
+		{input: " 6 ", want: VeryHard},
+		{input: "Very Easy", want: VeryEasy},
+		{input: "very-easy", want: VeryEasy},
+		{input: "VERY_HARD", want: VeryHard},
+		{input: "medium", want: Medium},
+		{input: "0", wantErr: ErrDifficultyUnset},
+		{input: "7", wantErr: ErrInvalidDifficulty},
+		{input: "", wantErr: ErrInvalidDifficulty},
+		{input: "Unknown", wantErr: ErrInvalidDifficulty},
+		{input: "4.5", wantErr: ErrInvalidDifficulty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDifficulty(tt.input)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseDifficulty(%q) = %v, %v, want %v, %v", tt.input, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func FuzzParseDifficulty(f *testing.F) {
+	for _, seed := range difficultySeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		difficulty, err := ParseDifficulty(s)
+		if err != nil {
+			if difficulty != 0 {
+				t.Errorf("ParseDifficulty(%q) = %d with error %v, want 0", s, difficulty, err)
+			}
+			if !errors.Is(err, ErrInvalidDifficulty) && !errors.Is(err, ErrDifficultyUnset) {
+				t.Errorf("ParseDifficulty(%q) unexpected error %v", s, err)
+			}
+			return
+		}
+
+		if err := validateDifficulty(difficulty); err != nil {
+			t.Fatalf("ParseDifficulty(%q) = %d, an invalid difficulty", s, difficulty)
+		}
+		if again, err := ParseDifficulty(difficulty.canonicalLabel()); err != nil || again != difficulty {
+			t.Errorf("ParseDifficulty(%q) = %v, %v, want %v", difficulty.canonicalLabel(), again, err, difficulty)
+		}
+	})
+}
+
+func FuzzDifficultyUnmarshalJSON(f *testing.F) {
+	for _, seed := range difficultySeeds {
+		f.Add([]byte(strconv.Quote(seed)))
+	}
+	for _, seed := range []string{"4", "0", "7", "-3", "4.5", "1e3", "null", "true", "{}", "[4]", `"`, "", "\x00"} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		difficulty := Medium
+		if err := difficulty.UnmarshalJSON(data); err != nil {
+			if difficulty != Medium {
+				t.Errorf("UnmarshalJSON(%q) changed the value to %d on error", data, difficulty)
+			}
+			return
+		}
+
+		if err := validateDifficulty(difficulty); err != nil {
+			t.Errorf("UnmarshalJSON(%q) = %d, an invalid difficulty", data, difficulty)
+		}
+	})
+}
+
+func TestSuggestNextDifficultySkipsInvalidated(t *testing.T) {
+	user, question := testID(1), testID(2)
+	answer := func(n int, isCorrect, invalidated bool) Answer {
+		a := newTestAnswer(n, user, question, isCorrect, time.Duration(n)*time.Minute)
+		a.Invalidated = invalidated
+		return a
+	}
+
+	tests := []struct {
+		name   string
+		recent []Answer
+		want   Difficulty
+	}{
+		{
+			name:   "invalidated errors do not break a correct streak",
+			recent: []Answer{answer(1, true, false), answer(2, false, true), answer(3, true, false)},
+			want:   Hard,
+		},
+		{
+			name:   "invalidated correct answers do not count toward the streak",
+			recent: []Answer{answer(1, false, false), answer(2, true, true), answer(3, true, true)},
+			want:   Medium,
+		},
+		{
+			name:   "only invalidated answers",
+			recent: []Answer{answer(1, false, true), answer(2, false, true)},
+			want:   Medium,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuggestNextDifficulty(Medium, tt.recent, 2, 2); got != tt.want {
+				t.Errorf("SuggestNextDifficulty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifficultyJSONRoundTrip(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "41", "43")
+
+	data, err := json.Marshal(*q)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"difficulty":"Medium"`) {
+		t.Errorf("json.Marshal(Question) = %s, want difficulty \"Medium\"", data)
+	}
+	if strings.Contains(string(data), `"difficulty":4`) {
+		t.Errorf("json.Marshal(Question) serialized the difficulty as a number")
+	}
+
+	var decoded Question
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !decoded.Equal(q) {
+		t.Errorf("round trip changed the question: %s", decoded.String())
+	}
+
+	for _, d := range difficultyLevels {
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("json.Marshal(%d) error = %v", d, err)
+		}
+		var got Difficulty
+		if err := json.Unmarshal(data, &got); err != nil || got != d {
+			t.Errorf("round trip of %s = %v, %v", data, got, err)
+		}
+	}
+}
+
+func TestSuggestNextDifficulty(t *testing.T) {
+	results := func(outcomes ...bool) []Answer {
+		answers := make([]Answer, len(outcomes))
+		for i, isCorrect := range outcomes {
+			answers[i] = newTestAnswer(i+1, testID(1), testID(2), isCorrect, time.Duration(i)*time.Minute)
+		}
+		return answers
+	}
+
+	tests := []struct {
+		name     string
+		current  Difficulty
+		recent   []Answer
+		stepUp   int
+		stepDown int
+		want     Difficulty
+	}{
+		{name: "no answers", current: Medium, stepUp: 3, stepDown: 2, want: Medium},
+		{name: "correct streak reaches stepUp", current: Medium, recent: results(false, true, true, true), stepUp: 3, stepDown: 2, want: Hard},
+		{name: "correct streak below stepUp", current: Medium, recent: results(true, false, true, true), stepUp: 3, stepDown: 2, want: Medium},
+		{name: "incorrect streak reaches stepDown", current: Medium, recent: results(true, false, false), stepUp: 3, stepDown: 2, want: Easy},
+		{name: "capped at VeryHard", current: VeryHard, recent: results(true, true, true), stepUp: 3, stepDown: 2, want: VeryHard},
+		{name: "capped at VeryEasy", current: VeryEasy, recent: results(false, false), stepUp: 3, stepDown: 2, want: VeryEasy},
+		{name: "stepUp disabled", current: Medium, recent: results(true, true, true), stepDown: 2, want: Medium},
+		{name: "stepDown disabled", current: Medium, recent: results(false, false, false), stepUp: 3, want: Medium},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuggestNextDifficulty(tt.current, tt.recent, tt.stepUp, tt.stepDown); got != tt.want {
+				t.Errorf("SuggestNextDifficulty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifficultyNextPrevious(t *testing.T) {
+	tests := []struct {
+		difficulty   Difficulty
+		wantNext     Difficulty
+		wantPrevious Difficulty
+	}{
+		{difficulty: VeryEasy, wantNext: Easy, wantPrevious: VeryEasy},
+		{difficulty: Medium, wantNext: Hard, wantPrevious: Easy},
+		{difficulty: VeryHard, wantNext: VeryHard, wantPrevious: Hard},
+		{difficulty: 0, wantNext: VeryEasy, wantPrevious: VeryEasy},
+		{difficulty: 9, wantNext: VeryHard, wantPrevious: VeryHard},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(int(tt.difficulty)), func(t *testing.T) {
+			if got := tt.difficulty.Next(); got != tt.wantNext {
+				t.Errorf("Next() = %v, want %v", got, tt.wantNext)
+			}
+			if got := tt.difficulty.Previous(); got != tt.wantPrevious {
+				t.Errorf("Previous() = %v, want %v", got, tt.wantPrevious)
+			}
+		})
+	}
+}
+
+func TestSuggestedTimeLimit(t *testing.T) {
+	tests := []struct {
+		difficulty Difficulty
+		want       time.Duration
+	}{
+		{difficulty: VeryEasy, want: 30 * time.Second},
+		{difficulty: Easy, want: 45 * time.Second},
+		{difficulty: Medium, want: 60 * time.Second},
+		{difficulty: Hard, want: 90 * time.Second},
+		{difficulty: VeryHard, want: 120 * time.Second},
+		{difficulty: 0, want: 0},
+		{difficulty: 7, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(int(tt.difficulty)), func(t *testing.T) {
+			if got := tt.difficulty.SuggestedTimeLimit(); got != tt.want {
+				t.Errorf("SuggestedTimeLimit() = %v, want %v", got, tt.want)
+			}
+			q := Question{Difficulty: tt.difficulty}
+			if got := q.TimeLimit(); got != tt.want {
+				t.Errorf("TimeLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifficultyUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    Difficulty
+		wantErr error
+	}{
+		{data: `3`, want: Easy},
+		{data: `"Medium"`, want: Medium},
+		{data: `"very-hard"`, want: VeryHard},
+		{data: `"5"`, want: Hard},
+		{data: `7`, wantErr: ErrInvalidDifficulty},
+		{data: `"Impossible"`, wantErr: ErrInvalidDifficulty},
+		{data: `0`, wantErr: ErrDifficultyUnset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var got Difficulty
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UnmarshalJSON(%s) error = %v, want %v", tt.data, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifficultyFromPoints(t *testing.T) {
+	for _, d := range difficultyLevels {
+		t.Run(d.canonicalLabel(), func(t *testing.T) {
+			got, err := DifficultyFromPoints(d.Points())
+			if err != nil || got != d {
+				t.Errorf("DifficultyFromPoints(%d) = %v, %v, want %v, nil", d.Points(), got, err, d)
+			}
+		})
+	}
+
+	for _, points := range []int{-1, 0, 6} {
+		t.Run(strconv.Itoa(points), func(t *testing.T) {
+			if _, err := DifficultyFromPoints(points); !errors.Is(err, ErrInvalidDifficulty) {
+				t.Errorf("DifficultyFromPoints(%d) error = %v, want %v", points, err, ErrInvalidDifficulty)
+			}
+		})
+	}
+}
+
+func TestSetDifficultyLabels(t *testing.T) {
+	t.Cleanup(func() { SetDifficultyLabels(nil) })
+
+	labels := map[Difficulty]string{
+		VeryEasy: "Muito Fácil",
+		Easy:     "Fácil",
+		Medium:   "Médio",
+		Hard:     "Difícil",
+	}
+	SetDifficultyLabels(labels)
+	labels[Easy] = "changed"
+
+	tests := []struct {
+		difficulty Difficulty
+		wantString string
+		wantJSON   string
+	}{
+		{difficulty: VeryEasy, wantString: "Muito Fácil", wantJSON: `"Very Easy"`},
+		{difficulty: Easy, wantString: "Fácil", wantJSON: `"Easy"`},
+		{difficulty: Medium, wantString: "Médio", wantJSON: `"Medium"`},
+		{difficulty: Hard, wantString: "Difícil", wantJSON: `"Hard"`},
+		{difficulty: VeryHard, wantString: "Very Hard", wantJSON: `"Very Hard"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantJSON, func(t *testing.T) {
+			if got := tt.difficulty.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+
+			data, err := json.Marshal(tt.difficulty)
+			if err != nil || string(data) != tt.wantJSON {
+				t.Errorf("json.Marshal() = %s, %v, want %s", data, err, tt.wantJSON)
+			}
+
+			var decoded Difficulty
+			if err := json.Unmarshal(data, &decoded); err != nil || decoded != tt.difficulty {
+				t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, decoded, err, tt.difficulty)
+			}
+			if parsed, err := ParseDifficulty(tt.difficulty.canonicalLabel()); err != nil || parsed != tt.difficulty {
+				t.Errorf("ParseDifficulty(%q) = %v, %v, want %v", tt.difficulty.canonicalLabel(), parsed, err, tt.difficulty)
+			}
+		})
+	}
+
+	SetDifficultyLabels(nil)
+	if got := Easy.String(); got != "Easy" {
+		t.Errorf("String() after reset = %q, want %q", got, "Easy")
+	}
+}
+
+func TestSetDifficultyLabelsConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetDifficultyLabels(nil) })
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				SetDifficultyLabels(map[Difficulty]string{Easy: "Fácil " + strconv.Itoa(i)})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if got := Easy.String(); got != "Easy" && !strings.HasPrefix(got, "Fácil ") {
+					t.Errorf("String() = %q, want a configured label", got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRecommendDifficulty(t *testing.T) {
+	perf := func(correct, incorrect int) *Performance {
+		p := newTestPerformance(10, correct, incorrect, time.Time{})
+		return &p
+	}
+
+	tests := []struct {
+		name    string
+		current Difficulty
+		perf    *Performance
+		want    Difficulty
+	}{
+		{name: "high accuracy steps up", current: Medium, perf: perf(9, 1), want: Hard},
+		{name: "exactly the high threshold keeps", current: Medium, perf: perf(8, 2), want: Medium},
+		{name: "low accuracy steps down", current: Medium, perf: perf(4, 6), want: Easy},
+		{name: "exactly the low threshold keeps", current: Medium, perf: perf(5, 5), want: Medium},
+		{name: "not enough answers", current: Medium, perf: perf(9, 0), want: Medium},
+		{name: "nil performance", current: Medium, want: Medium},
+		{name: "capped at VeryHard", current: VeryHard, perf: perf(10, 0), want: VeryHard},
+		{name: "capped at VeryEasy", current: VeryEasy, perf: perf(0, 10), want: VeryEasy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RecommendDifficulty(tt.current, tt.perf); got != tt.want {
+				t.Errorf("RecommendDifficulty() = %v, want %v", got, tt.want)
+			}
+
+			user := newTestUser(t, 1, "João Silva", "joao@example.com")
+			user.Difficulty = tt.current
+			if err := user.ApplyRecommendedDifficulty(tt.perf); err != nil {
+				t.Fatalf("ApplyRecommendedDifficulty() error = %v", err)
+			}
+			if user.Difficulty != tt.want {
+				t.Errorf("ApplyRecommendedDifficulty() Difficulty = %v, want %v", user.Difficulty, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDifficulty(t *testing.T) {
+	tests := []struct {
+		difficulty Difficulty
+		want       error
+	}{
+		{difficulty: 0, want: ErrDifficultyUnset},
+		{difficulty: 1, want: ErrInvalidDifficulty},
+		{difficulty: VeryEasy},
+		{difficulty: VeryHard},
+		{difficulty: 7, want: ErrInvalidDifficulty},
+		{difficulty: -1, want: ErrInvalidDifficulty},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(int(tt.difficulty)), func(t *testing.T) {
+			if err := validateDifficulty(tt.difficulty); !errors.Is(err, tt.want) {
+				t.Errorf("validateDifficulty(%d) error = %v, want %v", tt.difficulty, err, tt.want)
+			}
+			if _, err := FromInt(int(tt.difficulty)); !errors.Is(err, tt.want) {
+				t.Errorf("FromInt(%d) error = %v, want %v", tt.difficulty, err, tt.want)
+			}
+		})
+	}
+
+	q := Question{ID: testID(1000), SubjectID: testID(2000), Content: "What is 6 x 7?"}
+	err := q.Validate()
+	if !errors.Is(err, ErrDifficultyUnset) || errors.Is(err, ErrInvalidDifficulty) {
+		t.Errorf("Validate() without difficulty error = %v, want %v only", err, ErrDifficultyUnset)
+	}
+}
@@ -1,12 +1,54 @@
 package model
 
 import (
+	"errors"
+	"slices"
 	"strings"
 )
 
+// DomainError representa um erro de domínio com um código estável e legível
+// por máquina, permitindo que clientes tratem erros sem depender da mensagem.
+type DomainError struct {
+	Code    string
+	Message string
+}
+
+// Error implementa a interface error para DomainError
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+// newDomainError cria um erro sentinela com código e mensagem.
+func newDomainError(code, message string) error {
+	return &DomainError{Code: code, Message: message}
+}
+
+// Code retorna o código do primeiro DomainError encontrado em err, inclusive
+// dentro de ValidationError e de erros encapsulados com %w.
+//
+// Retorna uma string vazia se err não contém um DomainError.
+func Code(err error) string {
+	var de *DomainError
+	if errors.As(err, &de) {
+		return de.Code
+	}
+	return ""
+}
+
+// Erros específicos de validação
+var (
+	ErrTooManyValidationErrors = newDomainError("TOO_MANY_VALIDATION_ERRORS", "too many validation errors")
+)
+
+// MaxValidationErrors é a quantidade máxima de erros coletados por um
+// ValidationError. Os erros excedentes são contados, mas substituídos por um
+// único ErrTooManyValidationErrors.
+var MaxValidationErrors = 50
+
 // ValidationError contém múltiplos erros de validação
 type ValidationError struct {
 	Errors []error
+	count  int
 }
 
 // Error implementa a interface error para ValidationError
@@ -27,14 +69,97 @@ func (e *ValidationError) Unwrap() []error {
 	return e.Errors
 }
 
-// Add adiciona um erro à lista de erros de validação
+// Add adiciona um erro à lista de erros de validação.
+//
+// Após MaxValidationErrors erros, adiciona um único ErrTooManyValidationErrors
+// e deixa de coletar os erros seguintes.
 func (e *ValidationError) Add(err error) {
-	if err != nil {
+	if err == nil {
+		return
+	}
+
+	e.count++
+	switch {
+	case len(e.Errors) < MaxValidationErrors:
 		e.Errors = append(e.Errors, err)
+	case len(e.Errors) == MaxValidationErrors:
+		e.Errors = append(e.Errors, ErrTooManyValidationErrors)
 	}
 }
 
+// addAll adiciona err à lista de erros de validação. Se err for um
+// ValidationError, adiciona cada um dos erros contidos.
+func (e *ValidationError) addAll(err error) {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		for _, inner := range ve.Errors {
+			e.Add(inner)
+		}
+		return
+	}
+	e.Add(err)
+}
+
 // HasErrors verifica se há erros de validação
 func (e *ValidationError) HasErrors() bool {
 	return len(e.Errors) > 0
 }
+
+// Count retorna a quantidade de erros adicionados, incluindo os que não foram
+// coletados por exceder MaxValidationErrors.
+func (e *ValidationError) Count() int {
+	if e.count < len(e.Errors) {
+		return len(e.Errors)
+	}
+	return e.count
+}
+
+// sentinelErrors lista todos os erros sentinelas do pacote. Novos sentinelas
+// devem ser incluídos aqui para entrarem no registro e nas traduções; os testes
+// do pacote verificam que a lista está completa e que os códigos são únicos.
+var sentinelErrors = []error{
+	ErrAnswerIDEmpty, ErrInvalidTimeTaken, ErrQuestionMismatch, ErrInvalidAttempt,
+	ErrInvalidDifficulty, ErrChangeDifficulty, ErrDifficultyUnset,
+	ErrEmptyOptionContent, ErrQuantityOptions, ErrInvalidCorrectOptions,
+	ErrAddOptionExceedsLimit, ErrRemoveOptionBelowLimit, ErrOptionNotFound,
+	ErrOptionIDEmpty, ErrDuplicateOptionContent, ErrOptionQuestionIDMismatch,
+	ErrDuplicateOptionID, ErrOptionContentTooLong, ErrMultiplePinnedOptions,
+	ErrAmbiguousOptions, ErrMissingDistractorFeedback,
+	ErrPerformanceIDEmpty, ErrInvalidPerformanceData, ErrInvalidPeriod, ErrInvalidCounter,
+	ErrPerformanceMismatch, ErrPerformanceUserMismatch,
+	ErrQuestionIDEmpty, ErrEmptyQuestionContent, ErrQuestionContentTooLong,
+	ErrInvalidMediaURL, ErrIncompleteMedia, ErrSimplifyDifficulty, ErrInvalidQuestionType,
+	ErrQuestionNotFound, ErrInvalidVersion, ErrVersionConflict, ErrImpossibleMinOptions,
+	ErrInvalidSubjectName, ErrSubjectIDEmpty, ErrSubjectCycle, ErrSubjectTooDeep, ErrParentNotFound,
+	ErrMergeSameSubject, ErrSubjectNotFound,
+	ErrInvalidName, ErrInvalidRole, ErrEmptyRole, ErrInvalidEmail, ErrEmptyPassword,
+	ErrEmptyEmail, ErrUserIDEmpty, ErrInvalidStatus, ErrUserNotPending,
+	ErrNotEnoughQuestions, ErrUnsafeContent, ErrHTMLNotAllowed, ErrQuestionSubjectMismatch,
+	ErrTooManyValidationErrors, ErrInvalidID,
+}
+
+// errorRegistry indexa os erros sentinelas pelo código.
+var errorRegistry = make(map[string]error, len(sentinelErrors))
+
+func init() {
+	for _, err := range sentinelErrors {
+		errorRegistry[Code(err)] = err
+	}
+}
+
+// ErrorByCode retorna o erro sentinela com o código informado.
+func ErrorByCode(code string) (error, bool) {
+	err, ok := errorRegistry[code]
+	return err, ok
+}
+
+// AllErrorCodes retorna os códigos de todos os erros sentinelas, em ordem
+// alfabética.
+func AllErrorCodes() []string {
+	codes := make([]string, 0, len(errorRegistry))
+	for code := range errorRegistry {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
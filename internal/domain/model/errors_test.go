@@ -0,0 +1,199 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestAllErrorCodesUnique(t *testing.T) {
+	seen := make(map[string]error, len(sentinelErrors))
+	for _, err := range sentinelErrors {
+		code := Code(err)
+		if code == "" {
+			t.Errorf("sentinel %q has no code", err)
+			continue
+		}
+		if first, exists := seen[code]; exists {
+			t.Errorf("code %q used by %q and %q", code, first, err)
+		}
+		seen[code] = err
+	}
+
+	if got := len(AllErrorCodes()); got != len(sentinelErrors) {
+		t.Errorf("len(AllErrorCodes()) = %d, want %d", got, len(sentinelErrors))
+	}
+}
+
+// TestSentinelErrorsRegistered verifica que todo sentinela criado com
+// newDomainError nos arquivos do pacote está em sentinelErrors.
+func TestSentinelErrorsRegistered(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("ReadFile(%q) error = %v", name, err)
+		}
+		file, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatalf("ParseFile(%q) error = %v", name, err)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn, ok := call.Fun.(*ast.Ident)
+			if !ok || fn.Name != "newDomainError" || len(call.Args) == 0 {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+
+			code, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				t.Fatalf("%s: invalid code literal %s", fset.Position(lit.Pos()), lit.Value)
+			}
+			if _, ok := ErrorByCode(code); !ok {
+				t.Errorf("%s: sentinel %q is not listed in sentinelErrors", fset.Position(lit.Pos()), code)
+			}
+			return true
+		})
+	}
+}
+
+func TestErrorByCode(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   error
+		wantOK bool
+	}{
+		{code: "INVALID_DIFFICULTY", want: ErrInvalidDifficulty, wantOK: true},
+		{code: "VERSION_CONFLICT", want: ErrVersionConflict, wantOK: true},
+		{code: "UNKNOWN_CODE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, ok := ErrorByCode(tt.code)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ErrorByCode(%q) = %v, %v, want %v, %v", tt.code, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if codes := AllErrorCodes(); !slices.IsSorted(codes) {
+		t.Errorf("AllErrorCodes() is not sorted")
+	}
+}
+
+func TestCode(t *testing.T) {
+	ve := &ValidationError{}
+	ve.Add(ErrEmptyQuestionContent)
+
+	nested := &ValidationError{}
+	nested.Add(errors.New("plain"))
+	nested.Add(fmt.Errorf("option %q: %w", "a", ErrEmptyOptionContent))
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "sentinel", err: ErrInvalidRole, want: "INVALID_ROLE"},
+		{name: "wrapped", err: errors.Join(errors.New("context"), ErrQuantityOptions), want: "QUANTITY_OPTIONS"},
+		{name: "validation error", err: ve, want: "EMPTY_QUESTION_CONTENT"},
+		{name: "wrapped inside validation error", err: nested, want: "EMPTY_OPTION_CONTENT"},
+		{name: "plain error", err: errors.New("plain")},
+		{name: "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.err); got != tt.want {
+				t.Errorf("Code() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainError(t *testing.T) {
+	err := fmt.Errorf("[model.Test] ERROR: %w", ErrInvalidEmail)
+
+	if !errors.Is(err, ErrInvalidEmail) {
+		t.Errorf("errors.Is(%v, ErrInvalidEmail) = false, want true", err)
+	}
+	if errors.Is(err, ErrInvalidRole) {
+		t.Errorf("errors.Is(%v, ErrInvalidRole) = true, want false", err)
+	}
+
+	var de *DomainError
+	if !errors.As(err, &de) {
+		t.Fatalf("errors.As(%v, *DomainError) = false, want true", err)
+	}
+	if de.Code != "INVALID_EMAIL" || de.Error() != de.Message {
+		t.Errorf("DomainError = %+v, want code INVALID_EMAIL and Error() == Message", de)
+	}
+}
+
+func TestValidationErrorLimit(t *testing.T) {
+	original := MaxValidationErrors
+	MaxValidationErrors = 3
+	t.Cleanup(func() { MaxValidationErrors = original })
+
+	tests := []struct {
+		name        string
+		added       int
+		wantErrors  int
+		wantCount   int
+		wantTooMany bool
+	}{
+		{name: "empty"},
+		{name: "below the limit", added: 2, wantErrors: 2, wantCount: 2},
+		{name: "at the limit", added: 3, wantErrors: 3, wantCount: 3},
+		{name: "above the limit", added: 10, wantErrors: 4, wantCount: 10, wantTooMany: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ve := &ValidationError{}
+			for i := 0; i < tt.added; i++ {
+				ve.Add(fmt.Errorf("error %d", i))
+			}
+			ve.Add(nil)
+
+			if len(ve.Errors) != tt.wantErrors {
+				t.Errorf("len(Errors) = %d, want %d", len(ve.Errors), tt.wantErrors)
+			}
+			if ve.Count() != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", ve.Count(), tt.wantCount)
+			}
+			if ve.HasErrors() != (tt.added > 0) {
+				t.Errorf("HasErrors() = %v, want %v", ve.HasErrors(), tt.added > 0)
+			}
+			if got := errors.Is(ve, ErrTooManyValidationErrors); got != tt.wantTooMany {
+				t.Errorf("errors.Is(ErrTooManyValidationErrors) = %v, want %v", got, tt.wantTooMany)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// streamFlushInterval é a quantidade de respostas escritas entre cada envio
+// do buffer para o destino.
+const streamFlushInterval = 500
+
+// StreamAnswersJSON escreve em w um array JSON com as respostas recebidas pelo
+// canal, uma a uma, sem manter o conjunto em memória. O array é fechado quando
+// o canal é fechado; um canal fechado sem respostas produz "[]".
+//
+// A escrita é bufferizada e enviada a w a cada streamFlushInterval respostas.
+// Em caso de erro a escrita é interrompida e o canal deixa de ser lido: o
+// produtor deve ser cancelado pelo chamador.
+//
+// Em caso de erro retorna o erro de serialização ou de escrita.
+func StreamAnswersJSON(w io.Writer, answers <-chan Answer) error {
+	bw := bufio.NewWriter(w)
+
+	if err := bw.WriteByte('['); err != nil {
+		return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+	}
+
+	count := 0
+	for answer := range answers {
+		data, err := json.Marshal(answer)
+		if err != nil {
+			return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+		}
+
+		if count > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+			}
+		}
+		if _, err := bw.Write(data); err != nil {
+			return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+		}
+
+		count++
+		if count%streamFlushInterval == 0 {
+			if err := bw.Flush(); err != nil {
+				return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+			}
+		}
+	}
+
+	if err := bw.WriteByte(']'); err != nil {
+		return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("[model.StreamAnswersJSON] ERROR: %w", err)
+	}
+	return nil
+}
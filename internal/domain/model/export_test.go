@@ -0,0 +1,75 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// failingWriter é um io.Writer que sempre falha.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+// feedAnswers retorna um canal fechado com as respostas informadas.
+func feedAnswers(answers []Answer) <-chan Answer {
+	ch := make(chan Answer, len(answers))
+	for _, a := range answers {
+		ch <- a
+	}
+	close(ch)
+	return ch
+}
+
+func TestStreamAnswersJSON(t *testing.T) {
+	answers := func(n int) []Answer {
+		list := make([]Answer, n)
+		for i := range list {
+			list[i] = newTestAnswer(i+1, testID(1), testID(2), i%2 == 0, time.Duration(i)*time.Second)
+		}
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		answers []Answer
+	}{
+		{name: "zero", answers: []Answer{}},
+		{name: "one", answers: answers(1)},
+		{name: "several", answers: answers(3)},
+		{name: "more than one flush", answers: answers(streamFlushInterval*2 + 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := StreamAnswersJSON(&buf, feedAnswers(tt.answers)); err != nil {
+				t.Fatalf("StreamAnswersJSON() error = %v", err)
+			}
+
+			var decoded []Answer
+			if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v, output %q", err, buf.String())
+			}
+			if decoded == nil || len(decoded) != len(tt.answers) {
+				t.Fatalf("decoded %d answers, want %d", len(decoded), len(tt.answers))
+			}
+			for i := range decoded {
+				if decoded[i].ID != tt.answers[i].ID || decoded[i].IsCorrect != tt.answers[i].IsCorrect || !decoded[i].CreatedAt.Equal(tt.answers[i].CreatedAt) {
+					t.Errorf("decoded[%d] = %+v, want %+v", i, decoded[i], tt.answers[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamAnswersJSONWriteError(t *testing.T) {
+	answers := make([]Answer, streamFlushInterval)
+	if err := StreamAnswersJSON(failingWriter{}, feedAnswers(answers)); err == nil {
+		t.Errorf("StreamAnswersJSON() error = nil, want write error")
+	}
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DifficultyGrade contém os totais de um nível de dificuldade em um GradeReport.
+type DifficultyGrade struct {
+	Total     int `json:"total"`
+	Correct   int `json:"correct"`
+	Incorrect int `json:"incorrect"`
+}
+
+// GradeReport representa o resultado da correção de um conjunto de respostas.
+//
+// Accuracy é um percentual de 0 a 100, como Performance.GetAccuracy.
+// MissedQuestionIDs lista, sem repetição e na ordem das respostas, as perguntas
+// com ao menos uma resposta incorreta. UngradedAnswerIDs lista as respostas que
+// não puderam ser corrigidas e não entram nos totais.
+type GradeReport struct {
+	Total             int                            `json:"total"`
+	Correct           int                            `json:"correct"`
+	Incorrect         int                            `json:"incorrect"`
+	Accuracy          float64                        `json:"accuracy"`
+	ByDifficulty      map[Difficulty]DifficultyGrade `json:"byDifficulty"`
+	MissedQuestionIDs []string                       `json:"missedQuestionIds"`
+	UngradedAnswerIDs []string                       `json:"ungradedAnswerIds"`
+}
+
+// GradeBatch corrige as respostas a partir das opções atuais das perguntas
+// informadas e monta o relatório com os totais, a precisão, os totais por
+// dificuldade e as perguntas erradas.
+//
+// Respostas invalidadas são ignoradas. Respostas cuja pergunta ou opção não é
+// encontrada são registradas em UngradedAnswerIDs. Nesse caso o relatório é
+// retornado junto com um ValidationError com um erro por resposta
+// (ErrQuestionNotFound ou ErrOptionNotFound).
+func GradeBatch(answers []Answer, questions map[string]*Question) (*GradeReport, error) {
+	ve := &ValidationError{}
+	report := &GradeReport{
+		ByDifficulty:      make(map[Difficulty]DifficultyGrade),
+		MissedQuestionIDs: []string{},
+		UngradedAnswerIDs: []string{},
+	}
+
+	for _, a := range answers {
+		if a.Invalidated {
+			continue
+		}
+
+		question, ok := questions[a.QuestionID]
+		if !ok {
+			ve.Add(fmt.Errorf("answer %q: %w", a.ID, ErrQuestionNotFound))
+			report.UngradedAnswerIDs = append(report.UngradedAnswerIDs, a.ID)
+			continue
+		}
+
+		option, found := question.FindOption(a.OptionID)
+		if !found {
+			ve.Add(fmt.Errorf("answer %q: %w", a.ID, ErrOptionNotFound))
+			report.UngradedAnswerIDs = append(report.UngradedAnswerIDs, a.ID)
+			continue
+		}
+
+		grade := report.ByDifficulty[question.Difficulty]
+		grade.Total++
+		report.Total++
+
+		if option.IsCorrect {
+			grade.Correct++
+			report.Correct++
+		} else {
+			grade.Incorrect++
+			report.Incorrect++
+			if !slices.Contains(report.MissedQuestionIDs, question.ID) {
+				report.MissedQuestionIDs = append(report.MissedQuestionIDs, question.ID)
+			}
+		}
+		report.ByDifficulty[question.Difficulty] = grade
+	}
+
+	if report.Total > 0 {
+		report.Accuracy = float64(report.Correct) / float64(report.Total) * 100
+	}
+
+	if ve.HasErrors() {
+		return report, ve
+	}
+	return report, nil
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGradeBatch(t *testing.T) {
+	easy := renumberQuestion(newTestQuestion(t, Easy, "42", "24"), 1000)
+	hard := renumberQuestion(newTestQuestion(t, Hard, "7", "8", "9"), 1100)
+	questions := map[string]*Question{easy.ID: easy, hard.ID: hard}
+
+	answer := func(n int, q *Question, optionID string) Answer {
+		return Answer{ID: testID(n), UserID: testID(1), QuestionID: q.ID, OptionID: optionID}
+	}
+	invalidated := answer(5, hard, testID(1102))
+	invalidated.Invalidated = true
+
+	answers := []Answer{
+		answer(1, easy, testID(1001)),
+		answer(2, hard, testID(1102)),
+		answer(3, hard, testID(1101)),
+		answer(4, hard, testID(1103)),
+		invalidated,
+		answer(6, easy, testID(1002)),
+	}
+
+	report, err := GradeBatch(answers, questions)
+	if err != nil {
+		t.Fatalf("GradeBatch() error = %v", err)
+	}
+
+	if report.Total != 5 || report.Correct != 2 || report.Incorrect != 3 || report.Accuracy != 40 {
+		t.Errorf("totals = %d, %d correct, %d incorrect, %v%%, want 5, 2, 3, 40%%", report.Total, report.Correct, report.Incorrect, report.Accuracy)
+	}
+	wantByDifficulty := map[Difficulty]DifficultyGrade{
+		Easy: {Total: 2, Correct: 1, Incorrect: 1},
+		Hard: {Total: 3, Correct: 1, Incorrect: 2},
+	}
+	if !maps.Equal(report.ByDifficulty, wantByDifficulty) {
+		t.Errorf("ByDifficulty = %v, want %v", report.ByDifficulty, wantByDifficulty)
+	}
+	if want := []string{hard.ID, easy.ID}; !slices.Equal(report.MissedQuestionIDs, want) {
+		t.Errorf("MissedQuestionIDs = %v, want %v", report.MissedQuestionIDs, want)
+	}
+	if len(report.UngradedAnswerIDs) != 0 {
+		t.Errorf("UngradedAnswerIDs = %v, want empty", report.UngradedAnswerIDs)
+	}
+}
+
+func TestGradeBatchUngraded(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+	answers := []Answer{
+		{ID: testID(1), QuestionID: q.ID, OptionID: testID(1001)},
+		{ID: testID(2), QuestionID: testID(9000), OptionID: testID(1001)},
+		{ID: testID(3), QuestionID: q.ID, OptionID: testID(9001)},
+	}
+
+	report, err := GradeBatch(answers, map[string]*Question{q.ID: q})
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Count() != 2 || !errors.Is(err, ErrQuestionNotFound) || !errors.Is(err, ErrOptionNotFound) {
+		t.Fatalf("GradeBatch() error = %v, want ErrQuestionNotFound and ErrOptionNotFound", err)
+	}
+	if report.Total != 1 || report.Correct != 1 || report.Accuracy != 100 {
+		t.Errorf("totals = %d, %d correct, %v%%, want 1, 1, 100%%", report.Total, report.Correct, report.Accuracy)
+	}
+	if want := []string{testID(2), testID(3)}; !slices.Equal(report.UngradedAnswerIDs, want) {
+		t.Errorf("UngradedAnswerIDs = %v, want %v", report.UngradedAnswerIDs, want)
+	}
+}
+
+func TestGradeReportJSONRoundTrip(t *testing.T) {
+	report := GradeReport{
+		Total:    3,
+		Correct:  2,
+		Accuracy: 200.0 / 3,
+		ByDifficulty: map[Difficulty]DifficultyGrade{
+			VeryEasy: {Total: 1, Correct: 1},
+			Hard:     {Total: 2, Correct: 1, Incorrect: 1},
+		},
+		MissedQuestionIDs: []string{testID(1)},
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"Very Easy":{`) || !strings.Contains(string(data), `"Hard":{`) {
+		t.Errorf("json.Marshal(GradeReport) = %s, want difficulty labels as keys", data)
+	}
+
+	var decoded GradeReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !maps.Equal(decoded.ByDifficulty, report.ByDifficulty) {
+		t.Errorf("ByDifficulty after round trip = %v, want %v", decoded.ByDifficulty, report.ByDifficulty)
+	}
+
+	averages := map[Difficulty]time.Duration{Easy: 1500 * time.Millisecond, VeryHard: time.Minute}
+	data, err = json.Marshal(averages)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"Easy":1500000000,"Very Hard":60000000000}`; string(data) != want {
+		t.Errorf("json.Marshal(averages) = %s, want %s", data, want)
+	}
+	var decodedAverages map[Difficulty]time.Duration
+	if err := json.Unmarshal(data, &decodedAverages); err != nil || !maps.Equal(decodedAverages, averages) {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", data, decodedAverages, err, averages)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Impossible":1}`), &decodedAverages); !errors.Is(err, ErrInvalidDifficulty) {
+		t.Errorf("json.Unmarshal() with an unknown key error = %v, want %v", err, ErrInvalidDifficulty)
+	}
+}
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Idiomas suportados para mensagens de erro.
+const (
+	LangEnglish    = "en"
+	LangPortuguese = "pt-BR"
+)
+
+// Localizer traduz erros de domínio para o idioma informado.
+type Localizer interface {
+	Localize(err error, lang string) string
+}
+
+// TableLocalizer traduz erros de domínio a partir de tabelas de mensagens
+// indexadas por idioma e código de erro.
+type TableLocalizer struct {
+	Messages map[string]map[string]string
+}
+
+// DefaultLocalizer é o Localizer usado por Localize, com as tabelas en e pt-BR.
+var DefaultLocalizer Localizer = &TableLocalizer{
+	Messages: map[string]map[string]string{
+		LangEnglish:    englishMessages(),
+		LangPortuguese: portugueseMessages,
+	},
+}
+
+// LocalizedError encapsula um erro cuja mensagem é traduzida para o idioma
+// Lang. O erro original continua acessível com errors.Is e errors.As.
+type LocalizedError struct {
+	Err  error
+	Lang string
+}
+
+// Error implementa a interface error para LocalizedError
+func (e *LocalizedError) Error() string {
+	return Localize(e.Err, e.Lang)
+}
+
+// Unwrap retorna o erro original
+func (e *LocalizedError) Unwrap() error {
+	return e.Err
+}
+
+// Localize traduz err para o idioma informado usando DefaultLocalizer.
+func Localize(err error, lang string) string {
+	return DefaultLocalizer.Localize(err, lang)
+}
+
+// Localize traduz err para o idioma informado.
+//
+// Para ValidationError traduz cada erro contido. Quando não há tradução para
+// o código ou idioma, retorna a mensagem original em inglês.
+func (l *TableLocalizer) Localize(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		prefix := l.message(lang, "VALIDATION_FAILED", "validation failed")
+		if !ve.HasErrors() {
+			return prefix
+		}
+
+		msgs := make([]string, 0, len(ve.Errors))
+		for _, e := range ve.Errors {
+			msgs = append(msgs, l.Localize(e, lang))
+		}
+		return prefix + ": " + strings.Join(msgs, "; ")
+	}
+
+	var de *DomainError
+	if !errors.As(err, &de) {
+		return err.Error()
+	}
+	return l.message(lang, de.Code, err.Error())
+}
+
+// message retorna a mensagem do código no idioma informado ou fallback.
+func (l *TableLocalizer) message(lang, code, fallback string) string {
+	if msg, ok := l.Messages[normalizeLang(lang)][code]; ok {
+		return msg
+	}
+	return fallback
+}
+
+// normalizeLang converte variações de idioma (ex.: "pt", "pt_br") para as
+// chaves das tabelas de mensagens.
+func normalizeLang(lang string) string {
+	lang = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+	switch {
+	case lang == "pt" || strings.HasPrefix(lang, "pt-"):
+		return LangPortuguese
+	case lang == "en" || strings.HasPrefix(lang, "en-"):
+		return LangEnglish
+	default:
+		return lang
+	}
+}
+
+// englishMessages monta a tabela em inglês a partir das mensagens dos erros
+// sentinelas.
+func englishMessages() map[string]string {
+	messages := map[string]string{"VALIDATION_FAILED": "validation failed"}
+	for _, err := range sentinelErrors {
+		messages[Code(err)] = err.Error()
+	}
+	return messages
+}
+
+// portugueseMessages contém as mensagens de erro em português.
+var portugueseMessages = map[string]string{
+	"VALIDATION_FAILED":          "falha na validação",
+	"TOO_MANY_VALIDATION_ERRORS": "erros de validação em excesso",
+	"INVALID_ID":                 "o ID deve ser um UUID v7 ou v5 válido",
+
+	"ANSWER_ID_EMPTY":    "o ID da resposta não pode ser vazio",
+	"INVALID_TIME_TAKEN": "o tempo de resposta deve ser zero ou positivo",
+	"QUESTION_MISMATCH":  "a resposta não pertence à pergunta",
+	"INVALID_ATTEMPT":    "a tentativa deve ser zero ou positiva",
+
+	"INVALID_DIFFICULTY": "a dificuldade deve estar entre Muito Fácil(2) e Muito Difícil(6)",
+	"CHANGE_DIFFICULTY":  "as opções atuais excedem a nova dificuldade",
+	"DIFFICULTY_UNSET":   "a dificuldade não foi informada",
+
+	"EMPTY_OPTION_CONTENT":        "o conteúdo da opção não pode ser vazio",
+	"QUANTITY_OPTIONS":            "quantidade de opções incompatível com a dificuldade",
+	"INVALID_CORRECT_OPTIONS":     "quantidade de opções corretas incompatível com o tipo da pergunta",
+	"ADD_OPTION_EXCEEDS_LIMIT":    "não é possível adicionar mais opções do que a dificuldade permite",
+	"REMOVE_OPTION_BELOW_LIMIT":   "não é possível ter menos opções do que a dificuldade exige",
+	"OPTION_NOT_FOUND":            "opção não encontrada",
+	"OPTION_ID_EMPTY":             "o ID da opção não pode ser vazio",
+	"DUPLICATE_OPTION_CONTENT":    "as opções não podem ter conteúdo duplicado",
+	"OPTION_QUESTION_ID_MISMATCH": "o ID da pergunta da opção não corresponde à pergunta",
+	"DUPLICATE_OPTION_ID":         "os IDs das opções devem ser únicos na pergunta",
+	"OPTION_CONTENT_TOO_LONG":     fmt.Sprintf("o conteúdo da opção não pode exceder %d caracteres", MaxOptionContentLength),
+	"MULTIPLE_PINNED_OPTIONS":     "uma pergunta pode ter no máximo uma opção fixa",
+	"AMBIGUOUS_OPTIONS":           "opções com o mesmo conteúdo não podem diferir quanto à correção",
+	"MISSING_DISTRACTOR_FEEDBACK": "as opções incorretas devem ter feedback",
+
+	"PERFORMANCE_ID_EMPTY":      "o ID do desempenho não pode ser vazio",
+	"INVALID_PERFORMANCE_DATA":  "dados de desempenho inválidos",
+	"INVALID_PERIOD":            "o período deve ser: daily, weekly, monthly ou yearly",
+	"INVALID_COUNTER":           "o contador deve ser zero ou positivo",
+	"PERFORMANCE_MISMATCH":      "os desempenhos devem pertencer ao mesmo usuário e disciplina",
+	"PERFORMANCE_USER_MISMATCH": "o desempenho não pertence ao usuário",
+
+	"QUESTION_ID_EMPTY":         "o ID da pergunta não pode ser vazio",
+	"EMPTY_QUESTION_CONTENT":    "o conteúdo da pergunta não pode ser vazio",
+	"QUESTION_CONTENT_TOO_LONG": fmt.Sprintf("o conteúdo da pergunta não pode exceder %d caracteres", MaxQuestionContentLength),
+	"INVALID_MEDIA_URL":         "a URL da mídia deve ser uma URL absoluta http ou https válida",
+	"INCOMPLETE_MEDIA":          "a URL e o tipo da mídia devem ser informados juntos",
+	"SIMPLIFY_DIFFICULTY":       "a dificuldade desejada não pode exceder a dificuldade atual",
+	"INVALID_QUESTION_TYPE":     "o tipo da pergunta deve ser SINGLE_CHOICE ou MULTIPLE_CHOICE",
+	"QUESTION_NOT_FOUND":        "pergunta não encontrada",
+	"INVALID_VERSION":           "a versão deve ser no mínimo 1",
+	"VERSION_CONFLICT":          "a versão não corresponde à versão esperada",
+	"IMPOSSIBLE_MIN_OPTIONS":    "a quantidade mínima de opções excede o máximo permitido pela dificuldade",
+
+	"INVALID_SUBJECT_NAME": "o nome da disciplina não pode ter menos de 3 caracteres",
+	"SUBJECT_ID_EMPTY":     "o ID da disciplina não pode ser vazio",
+	"SUBJECT_CYCLE":        "a hierarquia de disciplinas não pode conter ciclos",
+	"SUBJECT_TOO_DEEP":     "a hierarquia de disciplinas excede a profundidade máxima",
+	"PARENT_NOT_FOUND":     "disciplina pai não encontrada",
+	"MERGE_SAME_SUBJECT":   "não é possível mesclar uma disciplina com ela mesma",
+	"SUBJECT_NOT_FOUND":    "disciplina não encontrada",
+
+	"INVALID_NAME":     "o nome do usuário não pode ter menos de 3 caracteres",
+	"INVALID_ROLE":     "papel inválido",
+	"EMPTY_ROLE":       "o papel não pode ser vazio",
+	"INVALID_EMAIL":    "formato de e-mail inválido",
+	"EMPTY_PASSWORD":   "o hash da senha não pode ser vazio",
+	"EMPTY_EMAIL":      "o e-mail não pode ser vazio",
+	"USER_ID_EMPTY":    "o ID do usuário não pode ser vazio",
+	"INVALID_STATUS":   "status inválido",
+	"USER_NOT_PENDING": "o usuário não está aguardando verificação de e-mail",
+
+	"NOT_ENOUGH_QUESTIONS": "o banco possui menos perguntas do que o solicitado",
+	"UNSAFE_CONTENT":       "o texto contém HTML inseguro, como scripts ou manipuladores de eventos",
+	"HTML_NOT_ALLOWED":     "o texto não pode conter tags HTML",
+
+	"QUESTION_SUBJECT_MISMATCH": "a pergunta não pertence à disciplina",
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	ve := &ValidationError{}
+	ve.Add(ErrInvalidEmail)
+	ve.Add(ErrInvalidRole)
+
+	tests := []struct {
+		name string
+		err  error
+		lang string
+		want string
+	}{
+		{name: "portuguese", err: ErrInvalidEmail, lang: LangPortuguese, want: "formato de e-mail inválido"},
+		{name: "language variant", err: ErrInvalidEmail, lang: "pt_br", want: "formato de e-mail inválido"},
+		{name: "english", err: ErrInvalidEmail, lang: LangEnglish, want: "invalid email format"},
+		{name: "unknown language falls back to english", err: ErrInvalidEmail, lang: "fr", want: "invalid email format"},
+		{name: "wrapped sentinel", err: fmt.Errorf("[model.Test] ERROR: %w", ErrInvalidEmail), lang: "pt", want: "formato de e-mail inválido"},
+		{name: "plain error", err: errors.New("plain"), lang: "pt", want: "plain"},
+		{name: "validation error", err: ve, lang: "pt", want: "falha na validação: formato de e-mail inválido; " + portugueseMessages["INVALID_ROLE"]},
+		{name: "nil", lang: "pt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Localize(tt.err, tt.lang); got != tt.want {
+				t.Errorf("Localize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPortugueseMessagesComplete(t *testing.T) {
+	for _, code := range AllErrorCodes() {
+		if msg := portugueseMessages[code]; msg == "" {
+			t.Errorf("code %q has no pt-BR message", code)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"educational-reinforcement-platform/pkg"
+)
+
+// Erros específicos de identificadores
+var (
+	ErrInvalidID = newDomainError("INVALID_ID", "ID must be a valid UUID v7 or v5")
+)
+
+// validateID verifica se o ID é um UUID v7 válido ou um UUID v5, usado em IDs
+// determinísticos derivados de chaves naturais (ex.: pkg.StableOptionID).
+//
+// Em caso de erro retorna ErrInvalidID.
+func validateID(id string) error {
+	if !pkg.IsValidUUIDv7(id) && !pkg.IsValidUUIDv5(id) {
+		return ErrInvalidID
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"educational-reinforcement-platform/pkg"
+)
+
+func TestValidateID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want error
+	}{
+		{name: "uuid v7", id: testID(1)},
+		{name: "uuid v5", id: pkg.StableOptionID(testID(1), "42")},
+		{name: "not a uuid", id: "question-1", want: ErrInvalidID},
+		{name: "uuid v4", id: "9b2f6a0e-3c1d-4e8f-9a7b-1c2d3e4f5a6b", want: ErrInvalidID},
+		{name: "missing dashes", id: "00000000000070008000000000000001", want: ErrInvalidID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateID(tt.id); !errors.Is(err, tt.want) {
+				t.Errorf("validateID(%q) error = %v, want %v", tt.id, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelsRejectNonUUIDIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(ctx ValidationContext) error
+	}{
+		{name: "user", validate: func(ctx ValidationContext) error {
+			u := User{ID: "user-1", Name: "João Silva", Email: "joao@example.com", PasswordHash: "hash", Role: RoleUser, Difficulty: Medium, Status: StatusActive}
+			return u.ValidateCtx(ctx)
+		}},
+		{name: "subject", validate: func(ctx ValidationContext) error {
+			s := Subject{ID: "subject-1", Name: "Algebra"}
+			return s.ValidateCtx(ctx)
+		}},
+		{name: "answer", validate: func(ctx ValidationContext) error {
+			a := Answer{ID: "answer-1", UserID: testID(1), QuestionID: testID(2), OptionID: testID(3)}
+			return a.ValidateCtx(ctx)
+		}},
+	}
+
+	lenient := ValidationContext{Locale: LangEnglish}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.validate(DefaultValidationContext); !errors.Is(err, ErrInvalidID) {
+				t.Errorf("ValidateCtx(strict) error = %v, want %v", err, ErrInvalidID)
+			}
+			if err := tt.validate(lenient); err != nil {
+				t.Errorf("ValidateCtx(lenient) error = %v, want nil", err)
+			}
+		})
+	}
+}
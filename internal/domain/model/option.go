@@ -2,29 +2,46 @@ package model
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Erros específicos do modelo Option
 var (
-	ErrEmptyOptionContent     = errors.New("option content cannot be empty")
-	ErrQuantityOptions        = errors.New("number of options incompatible with the difficulty")
-	ErrInvalidCorrectOptions  = errors.New("there must be exactly one correct option")
-	ErrAddOptionExceedsLimit  = errors.New("cannot add more options than the difficulty allows")
-	ErrRemoveOptionBelowLimit = errors.New("cannot have fewer options than the difficulty requires")
-	ErrOptionNotFound         = errors.New("option not found")
-	ErrOptionIDEmpty          = errors.New("option ID cannot be empty")
+	ErrEmptyOptionContent        = newDomainError("EMPTY_OPTION_CONTENT", "option content cannot be empty")
+	ErrQuantityOptions           = newDomainError("QUANTITY_OPTIONS", "number of options incompatible with the difficulty")
+	ErrInvalidCorrectOptions     = newDomainError("INVALID_CORRECT_OPTIONS", "number of correct options incompatible with the question type")
+	ErrAddOptionExceedsLimit     = newDomainError("ADD_OPTION_EXCEEDS_LIMIT", "cannot add more options than the difficulty allows")
+	ErrRemoveOptionBelowLimit    = newDomainError("REMOVE_OPTION_BELOW_LIMIT", "cannot have fewer options than the difficulty requires")
+	ErrOptionNotFound            = newDomainError("OPTION_NOT_FOUND", "option not found")
+	ErrOptionIDEmpty             = newDomainError("OPTION_ID_EMPTY", "option ID cannot be empty")
+	ErrDuplicateOptionContent    = newDomainError("DUPLICATE_OPTION_CONTENT", "options cannot have duplicate content")
+	ErrOptionQuestionIDMismatch  = newDomainError("OPTION_QUESTION_ID_MISMATCH", "option question ID does not match the question")
+	ErrDuplicateOptionID         = newDomainError("DUPLICATE_OPTION_ID", "option IDs must be unique within a question")
+	ErrOptionContentTooLong      = newDomainError("OPTION_CONTENT_TOO_LONG", fmt.Sprintf("option content cannot exceed %d characters", MaxOptionContentLength))
+	ErrMultiplePinnedOptions     = newDomainError("MULTIPLE_PINNED_OPTIONS", "a question can have at most one pinned option")
+	ErrAmbiguousOptions          = newDomainError("AMBIGUOUS_OPTIONS", "options with the same content cannot differ in correctness")
+	ErrMissingDistractorFeedback = newDomainError("MISSING_DISTRACTOR_FEEDBACK", "incorrect options must have feedback")
 )
 
-// Option representa uma opção de resposta para uma pergunta
+// MaxOptionContentLength é a quantidade máxima de caracteres (runes) do
+// conteúdo de uma opção.
+const MaxOptionContentLength = 500
+
+// Option representa uma opção de resposta para uma pergunta.
+//
+// Pinned indica uma opção que deve ser exibida sempre por último, mesmo com as
+// opções embaralhadas (ex.: "nenhuma das anteriores"). Feedback explica ao
+// usuário por que a opção está correta ou incorreta.
 type Option struct {
 	ID         string    `json:"id"`
 	QuestionID string    `json:"questionId"`
 	Content    string    `json:"content"`
 	IsCorrect  bool      `json:"isCorrect"`
+	Pinned     bool      `json:"pinned"`
+	Feedback   string    `json:"feedback"`
 	CreatedAt  time.Time `json:"createdAt"`
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
@@ -33,14 +50,14 @@ type Option struct {
 //
 // Em caso de erro retorna ValidationError.
 func NewOption(id, questionID, content string, isCorrect bool) (*Option, error) {
-	now := time.Now()
+	timestamp := now()
 	option := &Option{
 		ID:         id,
 		QuestionID: questionID,
 		Content:    content,
 		IsCorrect:  isCorrect,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
 	}
 
 	if err := option.Validate(); err != nil {
@@ -54,10 +71,21 @@ func NewOption(id, questionID, content string, isCorrect bool) (*Option, error)
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func (o *Option) Validate() error {
+	return o.ValidateCtx(DefaultValidationContext)
+}
+
+// ValidateCtx verifica se os dados da opção são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (o *Option) ValidateCtx(ctx ValidationContext) error {
 	ve := &ValidationError{}
 
 	if strings.TrimSpace(o.ID) == "" {
-		ve.Add(ErrEmptyOptionContent)
+		ve.Add(ErrOptionIDEmpty)
+	} else if err := ctx.validateID(o.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if strings.TrimSpace(o.QuestionID) == "" {
@@ -65,37 +93,71 @@ func (o *Option) Validate() error {
 	}
 
 	if err := validateOptionContent(o.Content); err != nil {
-		ve.Add(ErrEmptyOptionContent)
+		ve.Add(err)
+	}
+
+	if err := ctx.validateSafeText(o.Content); err != nil {
+		ve.Add(err)
+	}
+
+	if err := ctx.validateSafeText(o.Feedback); err != nil {
+		ve.Add(err)
 	}
 
 	if ve.HasErrors() {
-		return ve
+		return ctx.localize(ve)
 	}
 	return nil
 }
 
 // validateOptionContent verifica se o conteúdo da opção é válido.
 //
-// Em caso de erro retorna ErrEmptyOptionContent.
+// Em caso de erro retorna ErrEmptyOptionContent ou ErrOptionContentTooLong.
 func validateOptionContent(content string) error {
 	if strings.TrimSpace(content) == "" {
 		return ErrEmptyOptionContent
 	}
+	if utf8.RuneCountInString(content) > MaxOptionContentLength {
+		return ErrOptionContentTooLong
+	}
 	return nil
 }
 
+// normalizeOptionContent normaliza o conteúdo da opção para comparação,
+// removendo espaços nas extremidades e ignorando maiúsculas e minúsculas.
+func normalizeOptionContent(content string) string {
+	return strings.ToLower(strings.TrimSpace(content))
+}
+
 // UpdateContent atualiza o conteúdo da opção.
 //
-// Em caso de erro retorna ErrEmptyOptionContent.
+// Em caso de erro retorna ErrEmptyOptionContent ou ErrOptionContentTooLong.
 func (o *Option) UpdateContent(newContent string) error {
 	if err := validateOptionContent(newContent); err != nil {
 		return err
 	}
 	o.Content = newContent
-	o.UpdatedAt = time.Now()
+	o.UpdatedAt = now()
 	return nil
 }
 
+// Clone retorna uma cópia independente da opção.
+func (o Option) Clone() Option {
+	return o
+}
+
+// FindOrphanedOptions retorna as opções cujo QuestionID não está no conjunto
+// de perguntas conhecidas.
+func FindOrphanedOptions(options []Option, questionIDs map[string]bool) []Option {
+	var orphaned []Option
+	for _, opt := range options {
+		if !questionIDs[opt.QuestionID] {
+			orphaned = append(orphaned, opt)
+		}
+	}
+	return orphaned
+}
+
 // String retorna a representação em JSON da opção
 func (o *Option) String() string {
 	data, err := json.MarshalIndent(o, "", "  ")
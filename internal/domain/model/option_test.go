@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestOptionsEqual(t *testing.T) {
+	base := []Option{
+		{ID: testID(1), Content: "42", IsCorrect: true, Feedback: "6 x 7 = 42"},
+		{ID: testID(2), Content: "41", Feedback: "Off by one"},
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(opts []Option) []Option
+		want   bool
+	}{
+		{name: "identical", mutate: func(opts []Option) []Option { return opts }, want: true},
+		{
+			name: "different IDs",
+			mutate: func(opts []Option) []Option {
+				opts[0].ID = testID(9)
+				return opts
+			},
+			want: true,
+		},
+		{
+			name: "edited feedback",
+			mutate: func(opts []Option) []Option {
+				opts[1].Feedback = "Count again"
+				return opts
+			},
+		},
+		{
+			name: "pinned",
+			mutate: func(opts []Option) []Option {
+				opts[1].Pinned = true
+				return opts
+			},
+		},
+		{name: "reordered", mutate: func(opts []Option) []Option { return []Option{opts[1], opts[0]} }},
+		{name: "removed", mutate: func(opts []Option) []Option { return opts[:1] }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := tt.mutate(append([]Option(nil), base...))
+			if got := OptionsEqual(base, other); got != tt.want {
+				t.Errorf("OptionsEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOptionContentLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "at the limit", content: strings.Repeat("a", MaxOptionContentLength)},
+		{name: "over the limit", content: strings.Repeat("a", MaxOptionContentLength+1), wantErr: ErrOptionContentTooLong},
+		{name: "multibyte at the limit", content: strings.Repeat("é", MaxOptionContentLength)},
+		{name: "multibyte over the limit", content: strings.Repeat("漢", MaxOptionContentLength+1), wantErr: ErrOptionContentTooLong},
+		{name: "blank", content: "   ", wantErr: ErrEmptyOptionContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOption(testID(1), testID(2), tt.content, true)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewOption() error = %v, want %v", err, tt.wantErr)
+			}
+
+			option := &Option{ID: testID(1), QuestionID: testID(2), Content: "42"}
+			if err := option.UpdateContent(tt.content); !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateContent() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindOrphanedOptions(t *testing.T) {
+	known := map[string]bool{testID(1000): true, testID(1100): true}
+	options := []Option{
+		{ID: testID(1), QuestionID: testID(1000)},
+		{ID: testID(2), QuestionID: testID(9000)},
+		{ID: testID(3), QuestionID: testID(1100)},
+		{ID: testID(4), QuestionID: ""},
+	}
+
+	tests := []struct {
+		name    string
+		known   map[string]bool
+		wantIDs []string
+	}{
+		{name: "mixed", known: known, wantIDs: []string{testID(2), testID(4)}},
+		{name: "no known questions", wantIDs: []string{testID(1), testID(2), testID(3), testID(4)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, opt := range FindOrphanedOptions(options, tt.known) {
+				got = append(got, opt.ID)
+			}
+			if !slices.Equal(got, tt.wantIDs) {
+				t.Errorf("FindOrphanedOptions() = %v, want %v", got, tt.wantIDs)
+			}
+		})
+	}
+}
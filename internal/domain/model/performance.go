@@ -1,19 +1,24 @@
 package model
 
 import (
+	"cmp"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
+	"text/tabwriter"
 	"time"
 )
 
 // Erros específicos do modelo Performance
 var (
-	ErrPerformanceIDEmpty     = errors.New("performance ID cannot be empty")
-	ErrInvalidPerformanceData = errors.New("invalid performance data")
-	ErrInvalidPeriod          = errors.New("period must be one of: daily, weekly, monthly, yearly")
-	ErrInvalidCounter         = errors.New("the counter must be zero or positive")
+	ErrPerformanceIDEmpty      = newDomainError("PERFORMANCE_ID_EMPTY", "performance ID cannot be empty")
+	ErrInvalidPerformanceData  = newDomainError("INVALID_PERFORMANCE_DATA", "invalid performance data")
+	ErrInvalidPeriod           = newDomainError("INVALID_PERIOD", "period must be one of: daily, weekly, monthly, yearly")
+	ErrInvalidCounter          = newDomainError("INVALID_COUNTER", "the counter must be zero or positive")
+	ErrPerformanceMismatch     = newDomainError("PERFORMANCE_MISMATCH", "performances must belong to the same user and subject")
+	ErrPerformanceUserMismatch = newDomainError("PERFORMANCE_USER_MISMATCH", "performance does not belong to the user")
 )
 
 // Period representa o período de tempo para o desempenho.
@@ -24,23 +29,35 @@ const (
 	PeriodWeekly  Period = "weekly"
 	PeriodMonthly Period = "monthly"
 	PeriodYearly  Period = "yearly"
+
+	// PeriodAllTime é um período sintético usado em desempenhos agregados. Não
+	// é aceito por Validate e não deve ser persistido.
+	PeriodAllTime Period = "all-time"
 )
 
 // Performance representa o desempenho do usuário em um determinado período.
+//
+// WindowStart é o início, em UTC, da janela do período (ver Period.Window) em
+// que o desempenho foi criado e compõe a chave natural (ver IdentityKey).
 type Performance struct {
-	ID           string    `json:"id"`
-	UserID       string    `json:"userId"`
-	SubjectID    string    `json:"subjectId"`
-	Period       Period    `json:"period"`
-	Correct      int       `json:"correct"`
-	Incorrect    int       `json:"incorrect"`
-	CalculatedAt time.Time `json:"calculatedAt"`
+	ID              string    `json:"id"`
+	UserID          string    `json:"userId"`
+	SubjectID       string    `json:"subjectId"`
+	Period          Period    `json:"period"`
+	Correct         int       `json:"correct"`
+	Incorrect       int       `json:"incorrect"`
+	FirstTryCorrect int       `json:"firstTryCorrect"`
+	CalculatedAt    time.Time `json:"calculatedAt"`
+	WindowStart     time.Time `json:"windowStart"`
 }
 
 // NewPerformance cria uma nova instância de Performance.
 //
 // Em caso de erro retorna ValidationError.
 func NewPerformance(id, userID, subjectID string, period Period, correct, incorrect int) (*Performance, error) {
+	calculatedAt := now()
+	windowStart, _, _ := period.Window(calculatedAt.UTC())
+
 	performance := &Performance{
 		ID:           id,
 		UserID:       userID,
@@ -48,7 +65,8 @@ func NewPerformance(id, userID, subjectID string, period Period, correct, incorr
 		Period:       period,
 		Correct:      correct,
 		Incorrect:    incorrect,
-		CalculatedAt: time.Now(),
+		CalculatedAt: calculatedAt,
+		WindowStart:  windowStart,
 	}
 
 	if err := performance.Validate(); err != nil {
@@ -61,10 +79,21 @@ func NewPerformance(id, userID, subjectID string, period Period, correct, incorr
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func (p *Performance) Validate() error {
+	return p.ValidateCtx(DefaultValidationContext)
+}
+
+// ValidateCtx verifica se os dados do desempenho são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (p *Performance) ValidateCtx(ctx ValidationContext) error {
 	ve := &ValidationError{}
 
 	if strings.TrimSpace(p.ID) == "" {
 		ve.Add(ErrPerformanceIDEmpty)
+	} else if err := ctx.validateID(p.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if strings.TrimSpace(p.UserID) == "" {
@@ -87,6 +116,30 @@ func (p *Performance) Validate() error {
 		ve.Add(ErrInvalidCounter)
 	}
 
+	if p.FirstTryCorrect < 0 || p.FirstTryCorrect > p.Correct {
+		ve.Add(ErrInvalidCounter)
+	}
+
+	if ve.HasErrors() {
+		return ctx.localize(ve)
+	}
+	return nil
+}
+
+// ValidatePerformanceForUser verifica se o desempenho e o usuário são válidos
+// e se o desempenho pertence ao usuário.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados.
+func ValidatePerformanceForUser(p *Performance, u *User) error {
+	ve := &ValidationError{}
+
+	ve.addAll(p.Validate())
+	ve.addAll(u.Validate())
+
+	if p.UserID != u.ID {
+		ve.Add(ErrPerformanceUserMismatch)
+	}
+
 	if ve.HasErrors() {
 		return ve
 	}
@@ -105,25 +158,120 @@ func validatePeriod(period Period) error {
 	}
 }
 
+// Window retorna o início e o fim da janela do período que contém ref.
+//
+// A janela é o intervalo semiaberto [start, end), calculado no fuso horário
+// de ref, de modo que mudanças de horário de verão não deslocam os limites.
+// Semanas começam na segunda-feira.
+//
+// Em caso de erro retorna ErrInvalidPeriod.
+func (p Period) Window(ref time.Time) (start, end time.Time, err error) {
+	y, m, d := ref.Date()
+	loc := ref.Location()
+
+	switch p {
+	case PeriodDaily:
+		start = time.Date(y, m, d, 0, 0, 0, 0, loc)
+		end = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	case PeriodWeekly:
+		offset := (int(ref.Weekday()) + 6) % 7
+		start = time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+		end = time.Date(y, m, d-offset+7, 0, 0, 0, 0, loc)
+	case PeriodMonthly:
+		start = time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		end = time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+	case PeriodYearly:
+		start = time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+		end = time.Date(y+1, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Time{}, time.Time{}, ErrInvalidPeriod
+	}
+	return start, end, nil
+}
+
+// CurrentWindow retorna o início e o fim da janela do período que contém o
+// instante atual do relógio dos modelos (ver SetClock).
+//
+// Em caso de erro retorna ErrInvalidPeriod.
+func (p Period) CurrentWindow() (start, end time.Time, err error) {
+	return p.Window(now())
+}
+
+// Next retorna o início do período seguinte ao que contém ref, alinhado aos
+// limites de Window.
+//
+// Em caso de erro retorna ErrInvalidPeriod.
+func (p Period) Next(ref time.Time) (time.Time, error) {
+	_, end, err := p.Window(ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return end, nil
+}
+
+// PreviousWindow retorna o início e o fim da janela imediatamente anterior à
+// que contém ref, alinhada aos limites de Window.
+//
+// Em caso de erro retorna ErrInvalidPeriod.
+func (p Period) PreviousWindow(ref time.Time) (start, end time.Time, err error) {
+	current, _, err := p.Window(ref)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return p.Window(current.Add(-time.Nanosecond))
+}
+
 // UpdateCorrect incrementa o contador de acertos em 1.
 func (p *Performance) UpdateCorrect() error {
 	p.Correct++
-	p.CalculatedAt = time.Now()
+	p.CalculatedAt = now()
+	return nil
+}
+
+// UpdateCorrectFirstTry incrementa em 1 os contadores de acertos e de acertos
+// na primeira tentativa.
+func (p *Performance) UpdateCorrectFirstTry() error {
+	p.Correct++
+	p.FirstTryCorrect++
+	p.CalculatedAt = now()
 	return nil
 }
 
 // UpdateIncorrect incrementa o contador de erros em 1.
 func (p *Performance) UpdateIncorrect() error {
 	p.Incorrect++
-	p.CalculatedAt = time.Now()
+	p.CalculatedAt = now()
 	return nil
 }
 
+// ApplyAnswer atualiza os contadores do desempenho com a resposta informada:
+// acertos (e acertos na primeira tentativa, quando for o caso) ou erros.
+// Respostas invalidadas não alteram os contadores.
+//
+// Em caso de erro retorna ErrPerformanceUserMismatch.
+func (p *Performance) ApplyAnswer(a Answer) error {
+	if a.UserID != p.UserID {
+		return ErrPerformanceUserMismatch
+	}
+
+	switch {
+	case a.Invalidated:
+		return nil
+	case a.IsCorrect && a.IsFirstAttempt():
+		return p.UpdateCorrectFirstTry()
+	case a.IsCorrect:
+		return p.UpdateCorrect()
+	default:
+		return p.UpdateIncorrect()
+	}
+}
+
 // ResetCounters zera os contadores de acertos e erros.
 func (p *Performance) ResetCounters() error {
 	p.Correct = 0
 	p.Incorrect = 0
-	p.CalculatedAt = time.Now()
+	p.FirstTryCorrect = 0
+	p.CalculatedAt = now()
 	return nil
 }
 
@@ -136,6 +284,159 @@ func (p *Performance) GetAccuracy() float64 {
 	return (float64(p.Correct) / float64(total)) * 100
 }
 
+// MeetsGoal verifica se o desempenho atingiu a meta de precisão (percentual de
+// 0 a 100, como GetAccuracy) com ao menos minQuestions perguntas respondidas.
+//
+// A comparação evita a divisão para que uma precisão exatamente igual à meta
+// (ex.: 57 de 100 para 57%) seja considerada atingida.
+func (p *Performance) MeetsGoal(targetAccuracy float64, minQuestions int) bool {
+	total := p.Correct + p.Incorrect
+	if total == 0 || total < minQuestions {
+		return false
+	}
+	return float64(p.Correct)*100 >= targetAccuracy*float64(total)
+}
+
+// ProgressToGoal retorna o progresso em direção à meta de precisão (percentual
+// de 0 a 100) no intervalo de 0.0 a 1.0: a precisão atual dividida pela meta,
+// limitada a 1.0.
+//
+// Retorna 0.0 quando não há perguntas respondidas e 1.0 para metas menores ou
+// iguais a zero.
+func (p *Performance) ProgressToGoal(targetAccuracy float64) float64 {
+	total := p.Correct + p.Incorrect
+	if total == 0 {
+		return 0.0
+	}
+	if targetAccuracy <= 0 || p.MeetsGoal(targetAccuracy, 0) {
+		return 1.0
+	}
+	return min(p.GetAccuracy()/targetAccuracy, 1.0)
+}
+
+// Ratio retorna a proporção de acertos no intervalo de 0.0 a 1.0.
+//
+// Diferente de GetAccuracy, que retorna um percentual de 0 a 100.
+func (p *Performance) Ratio() float64 {
+	total := p.Correct + p.Incorrect
+	if total == 0 {
+		return 0.0
+	}
+	return float64(p.Correct) / float64(total)
+}
+
+// IsStale verifica se o desempenho foi calculado há mais de maxAge em relação
+// ao instante at e precisa ser recalculado.
+func (p *Performance) IsStale(at time.Time, maxAge time.Duration) bool {
+	return at.Sub(p.CalculatedAt) > maxAge
+}
+
+// StalePerformances retorna os desempenhos que precisam ser recalculados no
+// instante at (ver IsStale), na ordem original.
+func StalePerformances(perfs []Performance, at time.Time, maxAge time.Duration) []Performance {
+	var stale []Performance
+	for i := range perfs {
+		if perfs[i].IsStale(at, maxAge) {
+			stale = append(stale, perfs[i])
+		}
+	}
+	return stale
+}
+
+// WeightedAccuracy calcula a precisão ponderada pela dificuldade das perguntas.
+//
+// Cada resposta tem peso igual a Difficulty.Points() da pergunta correspondente,
+// de modo que acertos em perguntas difíceis contam mais. Respostas cuja pergunta
+// não está no mapa são ignoradas. Retorna 0 quando não há respostas a considerar.
+func WeightedAccuracy(answers []Answer, questions map[string]Question) float64 {
+	var earned, total int
+	for _, a := range answers {
+		q, ok := questions[a.QuestionID]
+		if !ok || a.Invalidated {
+			continue
+		}
+
+		points := q.Difficulty.Points()
+		total += points
+		if a.IsCorrect {
+			earned += points
+		}
+	}
+
+	if total == 0 {
+		return 0.0
+	}
+	return (float64(earned) / float64(total)) * 100
+}
+
+// DecayedAccuracy calcula a média da precisão (0 a 100) dos desempenhos,
+// ponderada por um decaimento exponencial pela idade de CalculatedAt em
+// relação ao instante at: um desempenho com idade halfLife vale metade de um
+// atual.
+//
+// Desempenhos sem perguntas respondidas são ignorados. Um halfLife menor ou
+// igual a zero desativa o decaimento. Retorna 0 quando não há desempenhos a
+// considerar.
+func DecayedAccuracy(perfs []Performance, halfLife time.Duration, at time.Time) float64 {
+	var weighted, totalWeight float64
+	for i := range perfs {
+		if perfs[i].GetTotalQuestions() == 0 {
+			continue
+		}
+
+		weight := 1.0
+		if halfLife > 0 {
+			age := max(at.Sub(perfs[i].CalculatedAt), 0)
+			weight = math.Pow(0.5, float64(age)/float64(halfLife))
+		}
+
+		weighted += perfs[i].GetAccuracy() * weight
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return 0.0
+	}
+	return weighted / totalWeight
+}
+
+// PercentileRank calcula o percentil (0 a 100) da precisão de target dentro
+// de cohort, contando os desempenhos com precisão menor ou igual à de target.
+//
+// Retorna 100 para um grupo com um único elemento e 0 para um grupo vazio.
+func PercentileRank(target Performance, cohort []Performance) float64 {
+	if len(cohort) == 0 {
+		return 0.0
+	}
+	if len(cohort) == 1 {
+		return 100.0
+	}
+
+	accuracy := target.GetAccuracy()
+	count := 0
+	for i := range cohort {
+		if cohort[i].GetAccuracy() <= accuracy {
+			count++
+		}
+	}
+	return (float64(count) / float64(len(cohort))) * 100
+}
+
+// IdentityKey retorna a chave natural do desempenho: usuário, disciplina,
+// período e WindowStart, em UTC (ex.: "<userId>|<subjectId>|weekly|2024-03-11").
+//
+// WindowStart é definido uma única vez por NewPerformance, de modo que as
+// atualizações dos contadores (que renovam CalculatedAt) não alteram a chave.
+// A chave serve para deduplicação e upsert nos repositórios; o identificador
+// primário continua sendo o ID. Sem WindowStart o início da janela fica vazio.
+func (p *Performance) IdentityKey() string {
+	windowStart := ""
+	if !p.WindowStart.IsZero() {
+		windowStart = p.WindowStart.UTC().Format(time.DateOnly)
+	}
+	return strings.Join([]string{p.UserID, p.SubjectID, string(p.Period), windowStart}, "|")
+}
+
 // GetTotalQuestions retorna o total de perguntas respondidas
 func (p *Performance) GetTotalQuestions() int {
 	return p.Correct + p.Incorrect
@@ -149,3 +450,85 @@ func (p *Performance) String() string {
 	}
 	return string(data)
 }
+
+// Merge soma os contadores de other ao desempenho. CalculatedAt passa a ser o
+// mais recente entre os dois.
+//
+// Em caso de erro retorna ErrPerformanceMismatch.
+func (p *Performance) Merge(other Performance) error {
+	if p.UserID != other.UserID || p.SubjectID != other.SubjectID {
+		return ErrPerformanceMismatch
+	}
+
+	p.Correct += other.Correct
+	p.Incorrect += other.Incorrect
+	p.FirstTryCorrect += other.FirstTryCorrect
+	if other.CalculatedAt.After(p.CalculatedAt) {
+		p.CalculatedAt = other.CalculatedAt
+	}
+	return nil
+}
+
+// AggregateBySubject soma os desempenhos de um usuário por disciplina,
+// produzindo um registro sintético por SubjectID com período PeriodAllTime e
+// ID vazio.
+//
+// O usuário considerado é o do primeiro desempenho; desempenhos de outros
+// usuários são ignorados.
+func AggregateBySubject(perfs []Performance) map[string]*Performance {
+	result := make(map[string]*Performance)
+	if len(perfs) == 0 {
+		return result
+	}
+
+	userID := perfs[0].UserID
+	for _, p := range perfs {
+		if p.UserID != userID {
+			continue
+		}
+
+		agg, ok := result[p.SubjectID]
+		if !ok {
+			agg = &Performance{
+				UserID:    userID,
+				SubjectID: p.SubjectID,
+				Period:    PeriodAllTime,
+			}
+			result[p.SubjectID] = agg
+		}
+		_ = agg.Merge(p)
+	}
+	return result
+}
+
+// SortPerformancesByAccuracy ordena os desempenhos por precisão decrescente,
+// depois pelo total de perguntas decrescente e, por fim, pelo ID do usuário
+// crescente, garantindo a mesma ordem entre execuções (ex.: em rankings).
+func SortPerformancesByAccuracy(perfs []Performance) {
+	slices.SortStableFunc(perfs, func(a, b Performance) int {
+		if c := cmp.Compare(b.GetAccuracy(), a.GetAccuracy()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.GetTotalQuestions(), a.GetTotalQuestions()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.UserID, b.UserID)
+	})
+}
+
+// FormatPerformanceTable retorna uma tabela ASCII alinhada com os desempenhos
+// informados, ordenados com SortPerformancesByAccuracy.
+func FormatPerformanceTable(perfs []Performance) string {
+	sorted := make([]Performance, len(perfs))
+	copy(sorted, perfs)
+	SortPerformancesByAccuracy(sorted)
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SUBJECT\tPERIOD\tCORRECT\tINCORRECT\tACCURACY")
+	for _, p := range sorted {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.1f%%\n", p.SubjectID, p.Period, p.Correct, p.Incorrect, p.GetAccuracy())
+	}
+	w.Flush()
+	return sb.String()
+}
@@ -0,0 +1,674 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+// newTestPerformance cria um desempenho válido calculado no instante informado.
+func newTestPerformance(n int, correct, incorrect int, calculatedAt time.Time) Performance {
+	return Performance{
+		ID:           testID(n),
+		UserID:       testID(1),
+		SubjectID:    testID(2),
+		Period:       PeriodWeekly,
+		Correct:      correct,
+		Incorrect:    incorrect,
+		CalculatedAt: calculatedAt,
+	}
+}
+
+func TestStalePerformances(t *testing.T) {
+	at := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	maxAge := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		calculatedAt time.Time
+		want         bool
+	}{
+		{name: "fresh", calculatedAt: at.Add(-time.Hour)},
+		{name: "exactly max age", calculatedAt: at.Add(-maxAge)},
+		{name: "older than max age", calculatedAt: at.Add(-maxAge - time.Second), want: true},
+		{name: "never calculated", want: true},
+	}
+
+	var perfs []Performance
+	var want []string
+	for i, tt := range tests {
+		p := newTestPerformance(10+i, 1, 1, tt.calculatedAt)
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.IsStale(at, maxAge); got != tt.want {
+				t.Errorf("IsStale() = %v, want %v", got, tt.want)
+			}
+		})
+		perfs = append(perfs, p)
+		if tt.want {
+			want = append(want, p.ID)
+		}
+	}
+
+	stale := StalePerformances(perfs, at, maxAge)
+	if len(stale) != len(want) {
+		t.Fatalf("len(StalePerformances()) = %d, want %d", len(stale), len(want))
+	}
+	for i := range stale {
+		if stale[i].ID != want[i] {
+			t.Errorf("StalePerformances()[%d].ID = %q, want %q", i, stale[i].ID, want[i])
+		}
+	}
+}
+
+func TestDecayedAccuracy(t *testing.T) {
+	at := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	halfLife := 7 * 24 * time.Hour
+	recent, old := at.Add(-24*time.Hour), at.Add(-60*24*time.Hour)
+
+	recentStrong := []Performance{
+		newTestPerformance(10, 9, 1, recent),
+		newTestPerformance(11, 2, 8, old),
+	}
+	oldStrong := []Performance{
+		newTestPerformance(12, 2, 8, recent),
+		newTestPerformance(13, 9, 1, old),
+	}
+
+	tests := []struct {
+		name     string
+		perfs    []Performance
+		halfLife time.Duration
+		wantMin  float64
+		wantMax  float64
+	}{
+		{name: "recent strong history", perfs: recentStrong, halfLife: halfLife, wantMin: 85, wantMax: 90},
+		{name: "old strong history", perfs: oldStrong, halfLife: halfLife, wantMin: 20, wantMax: 25},
+		{name: "decay disabled", perfs: recentStrong, wantMin: 55, wantMax: 55},
+		{name: "no answers", perfs: []Performance{newTestPerformance(14, 0, 0, recent)}},
+		{name: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecayedAccuracy(tt.perfs, tt.halfLife, at)
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("DecayedAccuracy() = %.2f, want between %.2f and %.2f", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+
+	if DecayedAccuracy(recentStrong, halfLife, at) <= DecayedAccuracy(oldStrong, halfLife, at) {
+		t.Errorf("recent strong history does not outweigh old strong history")
+	}
+}
+
+func TestFormatPerformanceTable(t *testing.T) {
+	perfs := []Performance{
+		{SubjectID: "math", Period: PeriodWeekly, Correct: 1, Incorrect: 3},
+		{SubjectID: "history", Period: PeriodMonthly, Correct: 9, Incorrect: 1},
+	}
+
+	want := "SUBJECT  PERIOD   CORRECT  INCORRECT  ACCURACY\n" +
+		"history  monthly  9        1          90.0%\n" +
+		"math     weekly   1        3          25.0%\n"
+
+	if got := FormatPerformanceTable(perfs); got != want {
+		t.Errorf("FormatPerformanceTable() =\n%s\nwant\n%s", got, want)
+	}
+	if perfs[0].SubjectID != "math" {
+		t.Errorf("FormatPerformanceTable() reordered the input")
+	}
+	if got := FormatPerformanceTable(nil); got != "SUBJECT  PERIOD  CORRECT  INCORRECT  ACCURACY\n" {
+		t.Errorf("FormatPerformanceTable(nil) = %q", got)
+	}
+}
+
+func TestPeriodWindow(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		period    Period
+		ref       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   error
+	}{
+		{
+			name:      "daily on the DST start day",
+			period:    PeriodDaily,
+			ref:       time.Date(2024, time.March, 31, 10, 0, 0, 0, london),
+			wantStart: time.Date(2024, time.March, 31, 0, 0, 0, 0, london),
+			wantEnd:   time.Date(2024, time.April, 1, 0, 0, 0, 0, london),
+		},
+		{
+			name:      "monthly ending on the DST change",
+			period:    PeriodMonthly,
+			ref:       time.Date(2024, time.March, 31, 23, 59, 0, 0, london),
+			wantStart: time.Date(2024, time.March, 1, 0, 0, 0, 0, london),
+			wantEnd:   time.Date(2024, time.April, 1, 0, 0, 0, 0, london),
+		},
+		{
+			name:      "monthly after the DST end",
+			period:    PeriodMonthly,
+			ref:       time.Date(2024, time.October, 31, 23, 30, 0, 0, london),
+			wantStart: time.Date(2024, time.October, 1, 0, 0, 0, 0, london),
+			wantEnd:   time.Date(2024, time.November, 1, 0, 0, 0, 0, london),
+		},
+		{
+			name:      "monthly at the first instant of the month",
+			period:    PeriodMonthly,
+			ref:       time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly on a leap day",
+			period:    PeriodMonthly,
+			ref:       time.Date(2024, time.February, 29, 23, 59, 59, 0, time.UTC),
+			wantStart: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly on a Sunday across the DST change",
+			period:    PeriodWeekly,
+			ref:       time.Date(2024, time.March, 31, 12, 0, 0, 0, london),
+			wantStart: time.Date(2024, time.March, 25, 0, 0, 0, 0, london),
+			wantEnd:   time.Date(2024, time.April, 1, 0, 0, 0, 0, london),
+		},
+		{
+			name:      "weekly on a Monday",
+			period:    PeriodWeekly,
+			ref:       time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, time.April, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "yearly",
+			period:    PeriodYearly,
+			ref:       time.Date(2024, time.July, 4, 8, 0, 0, 0, london),
+			wantStart: time.Date(2024, time.January, 1, 0, 0, 0, 0, london),
+			wantEnd:   time.Date(2025, time.January, 1, 0, 0, 0, 0, london),
+		},
+		{name: "invalid period", period: Period("hourly"), ref: time.Now(), wantErr: ErrInvalidPeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.period.Window(tt.ref)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Window() error = %v, want %v", err, tt.wantErr)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Window() = [%v, %v), want [%v, %v)", start, end, tt.wantStart, tt.wantEnd)
+			}
+			if tt.wantErr == nil && (tt.ref.Before(start) || !tt.ref.Before(end)) {
+				t.Errorf("Window() = [%v, %v) does not contain %v", start, end, tt.ref)
+			}
+		})
+	}
+}
+
+func TestPeriodNextAndPreviousWindow(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		period        Period
+		ref           time.Time
+		wantNext      time.Time
+		wantPrevStart time.Time
+		wantPrevEnd   time.Time
+	}{
+		{
+			name:          "monthly in December",
+			period:        PeriodMonthly,
+			ref:           time.Date(2024, time.December, 15, 18, 30, 0, 0, time.UTC),
+			wantNext:      date(2025, time.January, 1),
+			wantPrevStart: date(2024, time.November, 1),
+			wantPrevEnd:   date(2024, time.December, 1),
+		},
+		{
+			name:          "monthly in January",
+			period:        PeriodMonthly,
+			ref:           time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantNext:      date(2025, time.February, 1),
+			wantPrevStart: date(2024, time.December, 1),
+			wantPrevEnd:   date(2025, time.January, 1),
+		},
+		{
+			name:          "weekly across the year boundary",
+			period:        PeriodWeekly,
+			ref:           date(2024, time.December, 31),
+			wantNext:      date(2025, time.January, 6),
+			wantPrevStart: date(2024, time.December, 23),
+			wantPrevEnd:   date(2024, time.December, 30),
+		},
+		{
+			name:          "daily on New Year's Eve",
+			period:        PeriodDaily,
+			ref:           time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+			wantNext:      date(2025, time.January, 1),
+			wantPrevStart: date(2024, time.December, 30),
+			wantPrevEnd:   date(2024, time.December, 31),
+		},
+		{
+			name:          "yearly",
+			period:        PeriodYearly,
+			ref:           date(2024, time.June, 1),
+			wantNext:      date(2025, time.January, 1),
+			wantPrevStart: date(2023, time.January, 1),
+			wantPrevEnd:   date(2024, time.January, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := tt.period.Next(tt.ref)
+			if err != nil || !next.Equal(tt.wantNext) {
+				t.Errorf("Next() = %v, %v, want %v", next, err, tt.wantNext)
+			}
+
+			start, end, err := tt.period.PreviousWindow(tt.ref)
+			if err != nil || !start.Equal(tt.wantPrevStart) || !end.Equal(tt.wantPrevEnd) {
+				t.Errorf("PreviousWindow() = [%v, %v), %v, want [%v, %v)", start, end, err, tt.wantPrevStart, tt.wantPrevEnd)
+			}
+
+			if nextStart, _, _ := tt.period.Window(next); !nextStart.Equal(next) {
+				t.Errorf("Next() = %v is not aligned with Window() start %v", next, nextStart)
+			}
+		})
+	}
+
+	if _, err := Period("hourly").Next(time.Now()); !errors.Is(err, ErrInvalidPeriod) {
+		t.Errorf("Next() with invalid period error = %v, want %v", err, ErrInvalidPeriod)
+	}
+	if _, _, err := Period("hourly").PreviousWindow(time.Now()); !errors.Is(err, ErrInvalidPeriod) {
+		t.Errorf("PreviousWindow() with invalid period error = %v, want %v", err, ErrInvalidPeriod)
+	}
+}
+
+func TestWeightedAccuracy(t *testing.T) {
+	user := testID(1)
+	easy, hard := testID(20), testID(21)
+	questions := map[string]Question{
+		easy: {ID: easy, Difficulty: Easy},
+		hard: {ID: hard, Difficulty: Hard},
+	}
+	invalidated := newTestAnswer(12, user, hard, false, 0)
+	invalidated.Invalidated = true
+
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    float64
+	}{
+		{
+			name:    "hard correct outweighs easy incorrect",
+			answers: []Answer{newTestAnswer(10, user, hard, true, 0), newTestAnswer(11, user, easy, false, 0)},
+			want:    4.0 / 6.0 * 100,
+		},
+		{
+			name:    "easy correct is outweighed by hard incorrect",
+			answers: []Answer{newTestAnswer(10, user, easy, true, 0), newTestAnswer(11, user, hard, false, 0)},
+			want:    2.0 / 6.0 * 100,
+		},
+		{
+			name:    "invalidated and unknown questions are ignored",
+			answers: []Answer{newTestAnswer(10, user, easy, true, 0), invalidated, newTestAnswer(13, user, testID(99), false, 0)},
+			want:    100,
+		},
+		{name: "no answers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeightedAccuracy(tt.answers, questions); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("WeightedAccuracy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerformanceRatio(t *testing.T) {
+	tests := []struct {
+		name      string
+		correct   int
+		incorrect int
+		want      float64
+	}{
+		{name: "all correct", correct: 10, want: 1.0},
+		{name: "all incorrect", incorrect: 10, want: 0.0},
+		{name: "empty", want: 0.0},
+		{name: "mixed", correct: 3, incorrect: 1, want: 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPerformance(10, tt.correct, tt.incorrect, time.Time{})
+			if got := p.Ratio(); got != tt.want {
+				t.Errorf("Ratio() = %v, want %v", got, tt.want)
+			}
+			if got := p.GetAccuracy(); got != tt.want*100 {
+				t.Errorf("GetAccuracy() = %v, want %v", got, tt.want*100)
+			}
+		})
+	}
+}
+
+func TestPercentileRank(t *testing.T) {
+	cohort := []Performance{
+		newTestPerformance(10, 5, 5, time.Time{}),
+		newTestPerformance(11, 5, 5, time.Time{}),
+		newTestPerformance(12, 8, 2, time.Time{}),
+		newTestPerformance(13, 2, 8, time.Time{}),
+	}
+
+	tests := []struct {
+		name   string
+		target Performance
+		cohort []Performance
+		want   float64
+	}{
+		{name: "tied accuracies count as not above", target: cohort[0], cohort: cohort, want: 75},
+		{name: "same accuracy as the tie", target: cohort[1], cohort: cohort, want: 75},
+		{name: "best", target: cohort[2], cohort: cohort, want: 100},
+		{name: "worst", target: cohort[3], cohort: cohort, want: 25},
+		{name: "single element", target: cohort[3], cohort: cohort[3:], want: 100},
+		{name: "empty cohort", target: cohort[0]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PercentileRank(tt.target, tt.cohort); got != tt.want {
+				t.Errorf("PercentileRank() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregateBySubject(t *testing.T) {
+	base := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	math1 := newTestPerformance(10, 3, 1, base)
+	math2 := newTestPerformance(11, 5, 2, base.Add(7*24*time.Hour))
+	math2.FirstTryCorrect = 4
+	history := newTestPerformance(12, 1, 1, base)
+	history.SubjectID = testID(3)
+	otherUser := newTestPerformance(13, 9, 0, base)
+	otherUser.UserID = testID(4)
+
+	got := AggregateBySubject([]Performance{math1, history, math2, otherUser})
+
+	want := map[string]Performance{
+		testID(2): {UserID: testID(1), SubjectID: testID(2), Period: PeriodAllTime, Correct: 8, Incorrect: 3, FirstTryCorrect: 4, CalculatedAt: math2.CalculatedAt},
+		testID(3): {UserID: testID(1), SubjectID: testID(3), Period: PeriodAllTime, Correct: 1, Incorrect: 1, CalculatedAt: base},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("len(AggregateBySubject()) = %d, want %d", len(got), len(want))
+	}
+	for subjectID, w := range want {
+		g, ok := got[subjectID]
+		if !ok {
+			t.Errorf("AggregateBySubject() missing subject %q", subjectID)
+			continue
+		}
+		if *g != w {
+			t.Errorf("AggregateBySubject()[%q] = %+v, want %+v", subjectID, *g, w)
+		}
+	}
+
+	if empty := AggregateBySubject(nil); len(empty) != 0 {
+		t.Errorf("AggregateBySubject(nil) = %v, want empty", empty)
+	}
+}
+
+func TestPerformanceStaleAfterClockAdvance(t *testing.T) {
+	fake := useFakeClock(t, time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC))
+	maxAge := 7 * 24 * time.Hour
+
+	perf, err := NewPerformance(testID(10), testID(1), testID(2), PeriodWeekly, 3, 1)
+	if err != nil {
+		t.Fatalf("NewPerformance() error = %v", err)
+	}
+	key := perf.IdentityKey()
+	if want := testID(1) + "|" + testID(2) + "|" + string(PeriodWeekly) + "|2024-03-04"; key != want {
+		t.Errorf("IdentityKey() = %q, want %q", key, want)
+	}
+
+	fake.Advance(maxAge)
+	if perf.IsStale(fake.Now(), maxAge) {
+		t.Errorf("IsStale() at exactly maxAge = true, want false")
+	}
+
+	fake.Advance(time.Second)
+	if !perf.IsStale(fake.Now(), maxAge) {
+		t.Errorf("IsStale() past maxAge = false, want true")
+	}
+
+	if err := perf.UpdateCorrect(); err != nil {
+		t.Fatalf("UpdateCorrect() error = %v", err)
+	}
+	if perf.IsStale(fake.Now(), maxAge) {
+		t.Errorf("IsStale() after recalculation = true, want false")
+	}
+	if got := perf.IdentityKey(); got != key {
+		t.Errorf("IdentityKey() after an update in the next window = %q, want %q", got, key)
+	}
+}
+
+func TestPerformanceUpdateCorrectFirstTry(t *testing.T) {
+	perf := newTestPerformance(10, 0, 0, time.Time{})
+
+	for _, update := range []func() error{perf.UpdateCorrectFirstTry, perf.UpdateCorrect, perf.UpdateCorrectFirstTry, perf.UpdateIncorrect} {
+		if err := update(); err != nil {
+			t.Fatalf("update error = %v", err)
+		}
+	}
+
+	if perf.Correct != 3 || perf.FirstTryCorrect != 2 || perf.Incorrect != 1 {
+		t.Errorf("counters = %d correct, %d first try, %d incorrect, want 3, 2, 1", perf.Correct, perf.FirstTryCorrect, perf.Incorrect)
+	}
+	if err := perf.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestValidatePerformanceForUser(t *testing.T) {
+	user := newTestUser(t, 1, "João Silva", "joao@example.com")
+
+	tests := []struct {
+		name      string
+		mutate    func(p *Performance, u *User)
+		wantCount int
+		wantErrs  []error
+	}{
+		{name: "matching IDs", mutate: func(*Performance, *User) {}},
+		{
+			name:      "mismatched user",
+			mutate:    func(p *Performance, _ *User) { p.UserID = testID(99) },
+			wantCount: 1,
+			wantErrs:  []error{ErrPerformanceUserMismatch},
+		},
+		{
+			name: "mismatch aggregated with invalid entities",
+			mutate: func(p *Performance, u *User) {
+				p.UserID = testID(99)
+				p.Incorrect = -1
+				u.Email = "invalid"
+			},
+			wantCount: 3,
+			wantErrs:  []error{ErrPerformanceUserMismatch, ErrInvalidCounter, ErrInvalidEmail},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := *user
+			p := newTestPerformance(10, 3, 1, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+			tt.mutate(&p, &u)
+
+			err := ValidatePerformanceForUser(&p, &u)
+			if tt.wantCount == 0 {
+				if err != nil {
+					t.Fatalf("ValidatePerformanceForUser() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var ve *ValidationError
+			if !errors.As(err, &ve) || ve.Count() != tt.wantCount {
+				t.Fatalf("ValidatePerformanceForUser() error = %v, want %d errors", err, tt.wantCount)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("ValidatePerformanceForUser() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPerformanceApplyAnswer(t *testing.T) {
+	base := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	fake := useFakeClock(t, base)
+	perf := newTestPerformance(10, 0, 0, time.Time{})
+
+	correct := newTestAnswer(20, testID(1), testID(1000), true, 0)
+	retry := newTestAnswer(21, testID(1), testID(1001), true, 0)
+	retry.Attempt = 2
+	incorrect := newTestAnswer(22, testID(1), testID(1002), false, 0)
+	invalidated := newTestAnswer(23, testID(1), testID(1003), false, 0)
+	invalidated.Invalidated = true
+
+	for i, a := range []Answer{correct, retry, incorrect, invalidated} {
+		fake.Advance(time.Minute)
+		if err := perf.ApplyAnswer(a); err != nil {
+			t.Fatalf("ApplyAnswer() #%d error = %v", i+1, err)
+		}
+	}
+
+	if perf.Correct != 2 || perf.FirstTryCorrect != 1 || perf.Incorrect != 1 {
+		t.Errorf("counters = %d correct, %d first try, %d incorrect, want 2, 1, 1", perf.Correct, perf.FirstTryCorrect, perf.Incorrect)
+	}
+	if want := base.Add(3 * time.Minute); !perf.CalculatedAt.Equal(want) {
+		t.Errorf("CalculatedAt = %v, want %v", perf.CalculatedAt, want)
+	}
+
+	other := newTestAnswer(24, testID(99), testID(1000), true, 0)
+	if err := perf.ApplyAnswer(other); !errors.Is(err, ErrPerformanceUserMismatch) {
+		t.Errorf("ApplyAnswer(other user) error = %v, want %v", err, ErrPerformanceUserMismatch)
+	}
+	if perf.Correct != 2 {
+		t.Errorf("Correct after mismatch = %d, want 2", perf.Correct)
+	}
+}
+
+func TestPerformanceMeetsGoal(t *testing.T) {
+	tests := []struct {
+		name         string
+		correct      int
+		incorrect    int
+		target       float64
+		minQuestions int
+		wantMeets    bool
+		wantProgress float64
+	}{
+		{name: "exactly at the target", correct: 8, incorrect: 2, target: 80, wantMeets: true, wantProgress: 1},
+		{name: "exactly at a non-binary target", correct: 57, incorrect: 43, target: 57, wantMeets: true, wantProgress: 1},
+		{name: "just below the target", correct: 79, incorrect: 21, target: 80, wantProgress: 79.0 / 80.0},
+		{name: "above the target", correct: 9, incorrect: 1, target: 80, wantMeets: true, wantProgress: 1},
+		{name: "exactly the minimum questions", correct: 8, incorrect: 2, target: 80, minQuestions: 10, wantMeets: true, wantProgress: 1},
+		{name: "below the minimum questions", correct: 8, incorrect: 1, target: 80, minQuestions: 10, wantProgress: 1},
+		{name: "half way", correct: 2, incorrect: 3, target: 80, wantProgress: 0.5},
+		{name: "no questions", target: 80},
+		{name: "zero target", correct: 0, incorrect: 4, target: 0, wantMeets: true, wantProgress: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perf := newTestPerformance(10, tt.correct, tt.incorrect, time.Time{})
+			if got := perf.MeetsGoal(tt.target, tt.minQuestions); got != tt.wantMeets {
+				t.Errorf("MeetsGoal(%v, %d) = %v, want %v", tt.target, tt.minQuestions, got, tt.wantMeets)
+			}
+			if got := perf.ProgressToGoal(tt.target); math.Abs(got-tt.wantProgress) > 1e-9 {
+				t.Errorf("ProgressToGoal(%v) = %v, want %v", tt.target, got, tt.wantProgress)
+			}
+		})
+	}
+}
+
+func TestPerformanceIdentityKey(t *testing.T) {
+	monday := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+	base := newTestPerformance(10, 3, 1, monday.Add(10*time.Hour))
+	base.WindowStart = monday
+
+	tests := []struct {
+		name     string
+		mutate   func(p *Performance)
+		wantSame bool
+	}{
+		{name: "other ID, counters and calculation time", mutate: func(p *Performance) {
+			p.ID = testID(11)
+			p.Correct = 9
+			p.CalculatedAt = monday.Add(14 * 24 * time.Hour)
+		}, wantSame: true},
+		{name: "same instant in another time zone", mutate: func(p *Performance) {
+			p.WindowStart = p.WindowStart.In(time.FixedZone("BRT", -3*60*60))
+		}, wantSame: true},
+		{name: "next window", mutate: func(p *Performance) { p.WindowStart = monday.Add(7 * 24 * time.Hour) }},
+		{name: "other subject", mutate: func(p *Performance) { p.SubjectID = testID(3) }},
+		{name: "other period", mutate: func(p *Performance) { p.Period = PeriodMonthly }},
+		{name: "other user", mutate: func(p *Performance) { p.UserID = testID(4) }},
+	}
+
+	key := base.IdentityKey()
+	if want := testID(1) + "|" + testID(2) + "|" + string(PeriodWeekly) + "|2024-03-04"; key != want {
+		t.Errorf("IdentityKey() = %q, want %q", key, want)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := base
+			tt.mutate(&other)
+			if got := other.IdentityKey() == key; got != tt.wantSame {
+				t.Errorf("IdentityKey() %q == %q is %v, want %v", other.IdentityKey(), key, got, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestSortPerformancesByAccuracy(t *testing.T) {
+	perf := func(userN, correct, incorrect int) Performance {
+		p := newTestPerformance(100+userN, correct, incorrect, time.Time{})
+		p.UserID = testID(userN)
+		return p
+	}
+
+	perfs := []Performance{
+		perf(5, 1, 1),  // 50%, 2 questions
+		perf(3, 4, 4),  // 50%, 8 questions
+		perf(4, 9, 1),  // 90%
+		perf(2, 2, 2),  // 50%, 4 questions
+		perf(1, 2, 2),  // 50%, 4 questions, lower user ID
+		perf(6, 0, 0),  // no questions
+		perf(7, 10, 0), // 100%
+	}
+
+	SortPerformancesByAccuracy(perfs)
+
+	want := []string{testID(7), testID(4), testID(3), testID(1), testID(2), testID(5), testID(6)}
+	for i, p := range perfs {
+		if p.UserID != want[i] {
+			t.Errorf("perfs[%d].UserID = %q, want %q", i, p.UserID, want[i])
+		}
+	}
+}
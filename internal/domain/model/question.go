@@ -1,43 +1,92 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"math/rand/v2"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
+
+	"educational-reinforcement-platform/pkg"
 )
 
 // Erros específicos do modelo Question
 var (
-	ErrQuestionIDEmpty      = errors.New("question ID cannot be empty")
-	ErrEmptyQuestionContent = errors.New("question content cannot be empty")
+	ErrQuestionIDEmpty        = newDomainError("QUESTION_ID_EMPTY", "question ID cannot be empty")
+	ErrEmptyQuestionContent   = newDomainError("EMPTY_QUESTION_CONTENT", "question content cannot be empty")
+	ErrQuestionContentTooLong = newDomainError("QUESTION_CONTENT_TOO_LONG", fmt.Sprintf("question content cannot exceed %d characters", MaxQuestionContentLength))
+	ErrInvalidMediaURL        = newDomainError("INVALID_MEDIA_URL", "media URL must be a valid absolute http or https URL")
+	ErrIncompleteMedia        = newDomainError("INCOMPLETE_MEDIA", "media URL and media type must be set together")
+	ErrSimplifyDifficulty     = newDomainError("SIMPLIFY_DIFFICULTY", "target difficulty cannot exceed the current difficulty")
+	ErrInvalidQuestionType    = newDomainError("INVALID_QUESTION_TYPE", "question type must be SINGLE_CHOICE or MULTIPLE_CHOICE")
+	ErrQuestionNotFound       = newDomainError("QUESTION_NOT_FOUND", "question not found")
+	ErrInvalidVersion         = newDomainError("INVALID_VERSION", "version must be at least 1")
+	ErrVersionConflict        = newDomainError("VERSION_CONFLICT", "version does not match the expected version")
+	ErrImpossibleMinOptions   = newDomainError("IMPOSSIBLE_MIN_OPTIONS", "minimum options exceed the maximum allowed by the difficulty")
+)
+
+// MaxQuestionContentLength é a quantidade máxima de caracteres (runes) do
+// conteúdo de uma pergunta.
+const MaxQuestionContentLength = 5000
+
+// QuestionType define os tipos de pergunta quanto às opções corretas.
+type QuestionType string
+
+const (
+	// QuestionTypeSingleChoice exige exatamente uma opção correta. Um tipo
+	// vazio é tratado como escolha única.
+	QuestionTypeSingleChoice QuestionType = "SINGLE_CHOICE"
+	// QuestionTypeMultipleChoice exige ao menos uma opção correta.
+	QuestionTypeMultipleChoice QuestionType = "MULTIPLE_CHOICE"
 )
 
-// Question representa uma pergunta
+// Question representa uma pergunta.
+//
+// Options deve ser alterado apenas pelos métodos da pergunta, que garantem as
+// regras de validação. Para somente leitura, prefira OptionsView, que retorna
+// uma cópia.
+//
+// Perguntas são criadas como rascunho (Published falso) e só aparecem em
+// quizzes após Publish.
+//
+// Version começa em 1 e é incrementada a cada alteração feita pelos métodos da
+// pergunta, permitindo controle de concorrência otimista na persistência.
 type Question struct {
-	ID         string     `json:"id"`
-	SubjectID  string     `json:"subjectId"`
-	Content    string     `json:"content"`
-	Difficulty Difficulty `json:"difficulty"`
-	Options    []Option   `json:"options"`
-	CreatedAt  time.Time  `json:"createdAt"`
-	UpdatedAt  time.Time  `json:"updatedAt"`
+	ID         string       `json:"id"`
+	SubjectID  string       `json:"subjectId"`
+	Content    string       `json:"content"`
+	Difficulty Difficulty   `json:"difficulty"`
+	Type       QuestionType `json:"type"`
+	Options    []Option     `json:"options"`
+	Tags       []string     `json:"tags"`
+	MediaURL   string       `json:"mediaUrl"`
+	MediaType  string       `json:"mediaType"`
+	Version    int          `json:"version"`
+	Published  bool         `json:"published"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	UpdatedAt  time.Time    `json:"updatedAt"`
 }
 
 // NewQuestion cria uma nova instância de Question.
 
 // Em caso de erro retorna ValidationError.
 func NewQuestion(id, subjectID, content string, difficulty Difficulty, options []Option) (*Question, error) {
-	now := time.Now()
+	timestamp := now()
 	question := &Question{
 		ID:         id,
 		SubjectID:  subjectID,
-		Content:    content,
+		Content:    normalizeQuestionContent(content),
 		Options:    options,
 		Difficulty: difficulty,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		Type:       QuestionTypeSingleChoice,
+		Version:    1,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
 	}
 
 	if err := question.Validate(); err != nil {
@@ -46,14 +95,55 @@ func NewQuestion(id, subjectID, content string, difficulty Difficulty, options [
 	return question, nil
 }
 
+// QuestionConstraints define regras adicionais de validação de perguntas que
+// podem ser configuradas por implantação.
+type QuestionConstraints struct {
+	// MinOptions é a quantidade mínima de opções exigida independentemente da
+	// dificuldade. Valores menores que VeryEasy(2) mantêm o mínimo padrão.
+	MinOptions int
+}
+
+// minOptions retorna a quantidade mínima de opções exigida pelas restrições.
+func (c QuestionConstraints) minOptions() int {
+	if c.MinOptions > int(VeryEasy) {
+		return c.MinOptions
+	}
+	return int(VeryEasy)
+}
+
 // Validate verifica se os dados da pergunta são válidos.
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados
 func (q *Question) Validate() error {
+	return q.ValidateWith(QuestionConstraints{})
+}
+
+// ValidateWith verifica se os dados da pergunta são válidos aplicando as
+// restrições informadas. Restrições com valor zero mantêm o comportamento padrão.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados
+func (q *Question) ValidateWith(constraints QuestionConstraints) error {
+	return q.validate(DefaultValidationContext, constraints)
+}
+
+// ValidateCtx verifica se os dados da pergunta são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (q *Question) ValidateCtx(ctx ValidationContext) error {
+	return q.validate(ctx, QuestionConstraints{})
+}
+
+// validate verifica os dados da pergunta com o contexto e as restrições
+// informados.
+func (q *Question) validate(ctx ValidationContext, constraints QuestionConstraints) error {
 	ve := &ValidationError{}
 
 	if strings.TrimSpace(q.ID) == "" {
 		ve.Add(ErrQuestionIDEmpty)
+	} else if err := ctx.validateID(q.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if strings.TrimSpace(q.SubjectID) == "" {
@@ -64,16 +154,39 @@ func (q *Question) Validate() error {
 		ve.Add(err)
 	}
 
+	if err := ctx.validateSafeText(q.Content); err != nil {
+		ve.Add(err)
+	}
+
+	for _, opt := range q.Options {
+		if err := ctx.validateSafeText(opt.Content); err != nil {
+			ve.Add(fmt.Errorf("option %q: %w", opt.ID, err))
+		}
+		if err := ctx.validateSafeText(opt.Feedback); err != nil {
+			ve.Add(fmt.Errorf("option %q feedback: %w", opt.ID, err))
+		}
+	}
+
 	if err := validateDifficulty(q.Difficulty); err != nil {
 		ve.Add(err)
 	}
 
-	if err := validateOptions(q.Options, q.Difficulty); err != nil {
+	if err := validateQuestionType(q.Type); err != nil {
 		ve.Add(err)
 	}
 
+	ve.addAll(validateOptionsWith(ctx, q.Options, q.Difficulty, q.Type, constraints))
+
+	if err := validateMedia(q.MediaURL, q.MediaType); err != nil {
+		ve.Add(err)
+	}
+
+	if q.Version < 1 {
+		ve.Add(ErrInvalidVersion)
+	}
+
 	if ve.HasErrors() {
-		return ve
+		return ctx.localize(ve)
 	}
 
 	return nil
@@ -81,20 +194,103 @@ func (q *Question) Validate() error {
 
 // validateQuestionContent verifica se o conteúdo da pergunta é válido.
 //
-// Em caso de erro retorna ErrEmptyQuestionContent.
+// Em caso de erro retorna ErrEmptyQuestionContent ou ErrQuestionContentTooLong.
 func validateQuestionContent(content string) error {
 	if strings.TrimSpace(content) == "" {
 		return ErrEmptyQuestionContent
 	}
+	if utf8.RuneCountInString(content) > MaxQuestionContentLength {
+		return ErrQuestionContentTooLong
+	}
+	return nil
+}
+
+// normalizeQuestionContent remove espaços nas extremidades e reduz sequências
+// de espaços a um único espaço. Quebras de linha são preservadas, mas linhas
+// em branco consecutivas são reduzidas a uma única quebra.
+func normalizeQuestionContent(content string) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			lines = append(lines, strings.Join(fields, " "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateQuestionType verifica se o tipo da pergunta é válido. Um tipo vazio
+// é aceito e tratado como escolha única.
+//
+// Em caso de erro retorna ErrInvalidQuestionType.
+func validateQuestionType(questionType QuestionType) error {
+	switch questionType {
+	case "", QuestionTypeSingleChoice, QuestionTypeMultipleChoice:
+		return nil
+	default:
+		return ErrInvalidQuestionType
+	}
+}
+
+// allowsCorrectCount verifica se o tipo de pergunta admite a quantidade de
+// opções corretas informada.
+func (t QuestionType) allowsCorrectCount(count int) bool {
+	if t == QuestionTypeMultipleChoice {
+		return count >= 1
+	}
+	return count == 1
+}
+
+// validateMedia verifica se a mídia da pergunta é válida. A mídia é opcional,
+// mas URL e tipo devem ser informados juntos. A URL deve ser absoluta, com
+// esquema http ou https e host, o que rejeita caminhos relativos e URLs como
+// javascript:.
+//
+// Em caso de erro retorna ErrIncompleteMedia ou ErrInvalidMediaURL.
+func validateMedia(mediaURL, mediaType string) error {
+	hasURL := strings.TrimSpace(mediaURL) != ""
+	hasType := strings.TrimSpace(mediaType) != ""
+
+	if !hasURL && !hasType {
+		return nil
+	}
+	if !hasURL || !hasType {
+		return ErrIncompleteMedia
+	}
+	u, err := url.Parse(mediaURL)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return ErrInvalidMediaURL
+	}
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return ErrInvalidMediaURL
+	}
 	return nil
 }
 
 // validateOptions verifica se a lista de opções é válida.
 //
-// Em caso de erro retorna: ErrQuantityOptions ou ErrInvalidCorrectOptions
-func validateOptions(options []Option, difficulty Difficulty) error {
-	if len(options) < int(VeryEasy) || len(options) > int(difficulty) {
-		return ErrQuantityOptions
+// Em caso de erro retorna: ErrQuantityOptions, ErrInvalidCorrectOptions ou
+// ErrDuplicateOptionContent.
+func validateOptions(options []Option, difficulty Difficulty, questionType QuestionType) error {
+	return validateOptionsWith(DefaultValidationContext, options, difficulty, questionType, QuestionConstraints{})
+}
+
+// validateOptionsWith verifica se a lista de opções é válida aplicando o
+// contexto de validação e as restrições informados.
+//
+// Em caso de erro retorna: ErrImpossibleMinOptions (encapsulado com o mínimo
+// exigido e o máximo da dificuldade), ErrQuantityOptions (encapsulado com a
+// quantidade recebida e a faixa permitida), ErrInvalidCorrectOptions, ErrAmbiguousOptions,
+// ErrDuplicateOptionContent, ErrMultiplePinnedOptions ou ValidationError com um
+// ErrMissingDistractorFeedback por opção incorreta sem feedback.
+func validateOptionsWith(ctx ValidationContext, options []Option, difficulty Difficulty, questionType QuestionType, constraints QuestionConstraints) error {
+	if validateDifficulty(difficulty) == nil && constraints.minOptions() > difficulty.MaxOptions() {
+		return fmt.Errorf("%w: MinOptions is %d, difficulty %s allows at most %d",
+			ErrImpossibleMinOptions, constraints.minOptions(), difficulty, difficulty.MaxOptions())
+	}
+
+	if len(options) < constraints.minOptions() || len(options) > difficulty.MaxOptions() {
+		return fmt.Errorf("%w: got %d options, difficulty %s allows %d-%d",
+			ErrQuantityOptions, len(options), difficulty, constraints.minOptions(), difficulty.MaxOptions())
 	}
 
 	correctCount := 0
@@ -104,22 +300,55 @@ func validateOptions(options []Option, difficulty Difficulty) error {
 		}
 	}
 
-	if correctCount != 1 {
+	if !questionType.allowsCorrectCount(correctCount) {
 		return ErrInvalidCorrectOptions
 	}
 
-	return nil
+	if hasAmbiguousOptions(options) {
+		return ErrAmbiguousOptions
+	}
+
+	if hasDuplicateOptions(options) {
+		return ErrDuplicateOptionContent
+	}
+
+	pinnedCount := 0
+	for _, opt := range options {
+		if opt.Pinned {
+			pinnedCount++
+		}
+	}
+
+	if pinnedCount > 1 {
+		return ErrMultiplePinnedOptions
+	}
+
+	return ctx.validateDistractorFeedback(options)
+}
+
+// hasDuplicateOptions verifica se há opções com o mesmo conteúdo normalizado.
+func hasDuplicateOptions(options []Option) bool {
+	seen := make(map[string]bool, len(options))
+	for _, opt := range options {
+		key := normalizeOptionContent(opt.Content)
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+	}
+	return false
 }
 
-// UpdateContent altera o conteúdo da pergunta.
+// UpdateContent altera o conteúdo da pergunta, normalizando os espaços.
 
-// Em caso de erro retorna ErrEmptyQuestionContent.
+// Em caso de erro retorna ErrEmptyQuestionContent ou ErrQuestionContentTooLong.
 func (q *Question) UpdateContent(newContent string) error {
+	newContent = normalizeQuestionContent(newContent)
 	if err := validateQuestionContent(newContent); err != nil {
 		return err
 	}
 	q.Content = newContent
-	q.UpdatedAt = time.Now()
+	q.touch(now())
 	return nil
 }
 
@@ -136,7 +365,7 @@ func (q *Question) UpdateDifficulty(newDifficulty Difficulty) error {
 	}
 
 	q.Difficulty = newDifficulty
-	q.UpdatedAt = time.Now()
+	q.touch(now())
 	return nil
 }
 
@@ -144,15 +373,56 @@ func (q *Question) UpdateDifficulty(newDifficulty Difficulty) error {
 //
 // Em caso de erro retorna ErrQuantityOptions ou ErrInvalidCorrectOptions.
 func (q *Question) UpdateOptions(newOptions []Option) error {
-	if err := validateOptions(newOptions, q.Difficulty); err != nil {
+	if err := validateOptions(newOptions, q.Difficulty, q.Type); err != nil {
 		return err
 	}
 	q.Options = newOptions
-	q.UpdatedAt = time.Now()
+	q.touch(now())
 	return nil
 }
 
-// AddOption adiciona uma nova opção à pergunta.
+// WithOptions substitui as opções da pergunta por cópias das informadas,
+// associando cada uma ao ID da pergunta e gerando IDs com pkg.NewID para as
+// opções sem ID.
+// Retorna a própria pergunta para encadeamento.
+//
+// Em caso de erro a pergunta não é alterada e retorna ValidationError,
+// ErrQuantityOptions ou ErrInvalidCorrectOptions.
+func (q *Question) WithOptions(opts ...Option) (*Question, error) {
+	newOptions := make([]Option, 0, len(opts))
+	ve := &ValidationError{}
+
+	for _, opt := range opts {
+		clone := opt.Clone()
+		clone.QuestionID = q.ID
+
+		if strings.TrimSpace(clone.ID) == "" {
+			id, err := pkg.NewID()
+			if err != nil {
+				return nil, fmt.Errorf("[model.Question.WithOptions] ERROR: %w", err)
+			}
+			clone.ID = id
+		}
+
+		ve.addAll(clone.Validate())
+		newOptions = append(newOptions, clone)
+	}
+
+	if ve.HasErrors() {
+		return nil, ve
+	}
+
+	if err := validateOptions(newOptions, q.Difficulty, q.Type); err != nil {
+		return nil, err
+	}
+
+	q.Options = newOptions
+	q.touch(now())
+	return q, nil
+}
+
+// AddOption adiciona uma cópia da opção à pergunta, associando-a ao ID da
+// pergunta. Alterações posteriores na opção informada não afetam a pergunta.
 //
 // Em caso de erro retorna: ErrAddOptionExceedsLimit, ErrQuantityOptions ou ErrInvalidCorrectOptions.
 func (q *Question) AddOption(option Option) error {
@@ -160,13 +430,16 @@ func (q *Question) AddOption(option Option) error {
 		return ErrAddOptionExceedsLimit
 	}
 
-	newOptions := append(q.Options, option)
-	if err := validateOptions(newOptions, q.Difficulty); err != nil {
+	clone := option.Clone()
+	clone.QuestionID = q.ID
+
+	newOptions := append(q.OptionsView(), clone)
+	if err := validateOptions(newOptions, q.Difficulty, q.Type); err != nil {
 		return err
 	}
 
 	q.Options = newOptions
-	q.UpdatedAt = time.Now()
+	q.touch(now())
 	return nil
 }
 
@@ -178,27 +451,23 @@ func (q *Question) RemoveOption(optionID string) error {
 		return ErrRemoveOptionBelowLimit
 	}
 
-	var newOptions []Option
-	var found bool
+	if _, found := q.FindOption(optionID); !found {
+		return ErrOptionNotFound
+	}
 
+	var newOptions []Option
 	for _, opt := range q.Options {
-		if opt.ID == optionID {
-			found = true
-			continue
+		if opt.ID != optionID {
+			newOptions = append(newOptions, opt)
 		}
-		newOptions = append(newOptions, opt)
 	}
 
-	if !found {
-		return ErrOptionNotFound
-	}
-
-	if err := validateOptions(newOptions, q.Difficulty); err != nil {
+	if err := validateOptions(newOptions, q.Difficulty, q.Type); err != nil {
 		return err
 	}
 
 	q.Options = newOptions
-	q.UpdatedAt = time.Now()
+	q.touch(now())
 	return nil
 }
 
@@ -206,32 +475,585 @@ func (q *Question) RemoveOption(optionID string) error {
 //
 // Em caso de erro retorna: ErrOptionNotFound, ErrQuantityOptions ou ErrInvalidCorrectOptions.
 func (q *Question) SetCorrectOption(optionID string) error {
-	found := false
-	now := time.Now()
+	if _, found := q.FindOption(optionID); !found {
+		return ErrOptionNotFound
+	}
 
+	timestamp := now()
 	for i := range q.Options {
-		if q.Options[i].ID == optionID {
-			q.Options[i].IsCorrect = true
-			q.Options[i].UpdatedAt = now
-			found = true
-		} else {
-			q.Options[i].IsCorrect = false
-			q.Options[i].UpdatedAt = now
-		}
+		q.Options[i].IsCorrect = q.Options[i].ID == optionID
+		q.Options[i].UpdatedAt = timestamp
 	}
 
+	if err := validateOptions(q.Options, q.Difficulty, q.Type); err != nil {
+		return err
+	}
+
+	q.touch(timestamp)
+	return nil
+}
+
+// UpdateType altera o tipo da pergunta, verificando se as opções atuais são
+// compatíveis com o novo tipo.
+//
+// Em caso de erro retorna ErrInvalidQuestionType ou ErrInvalidCorrectOptions.
+func (q *Question) UpdateType(newType QuestionType) error {
+	if err := validateQuestionType(newType); err != nil {
+		return err
+	}
+
+	if err := validateOptions(q.Options, q.Difficulty, newType); err != nil {
+		return err
+	}
+
+	q.Type = newType
+	q.touch(now())
+	return nil
+}
+
+// touch registra uma alteração na pergunta, atualizando UpdatedAt e
+// incrementando Version.
+func (q *Question) touch(timestamp time.Time) {
+	q.UpdatedAt = timestamp
+	q.Version++
+}
+
+// Publish publica a pergunta, tornando-a disponível para quizzes. A pergunta
+// é validada antes da publicação; perguntas inválidas permanecem em rascunho.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados.
+func (q *Question) Publish() error {
+	return q.PublishCtx(DefaultValidationContext)
+}
+
+// PublishCtx publica a pergunta como Publish, validando-a de acordo com o
+// contexto de validação informado (ex.: RequireDistractorFeedback).
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (q *Question) PublishCtx(ctx ValidationContext) error {
+	if err := q.ValidateCtx(ctx); err != nil {
+		return err
+	}
+	if !q.Published {
+		q.Published = true
+		q.touch(now())
+	}
+	return nil
+}
+
+// Unpublish retorna a pergunta para rascunho, removendo-a dos quizzes.
+func (q *Question) Unpublish() {
+	if q.Published {
+		q.Published = false
+		q.touch(now())
+	}
+}
+
+// IsPublished verifica se a pergunta está publicada
+func (q *Question) IsPublished() bool {
+	return q.Published
+}
+
+// CheckVersion verifica se a versão da pergunta é a esperada. Deve ser chamado
+// antes de persistir a pergunta para rejeitar gravações baseadas em uma cópia
+// desatualizada.
+//
+// Em caso de erro retorna ErrVersionConflict.
+func (q *Question) CheckVersion(expected int) error {
+	if q.Version != expected {
+		return fmt.Errorf("%w: got %d, expected %d", ErrVersionConflict, q.Version, expected)
+	}
+	return nil
+}
+
+// IsMultipleChoice verifica se a pergunta admite mais de uma opção correta
+func (q *Question) IsMultipleChoice() bool {
+	return q.Type == QuestionTypeMultipleChoice
+}
+
+// ToggleCorrect inverte a marcação de correta da opção informada, mantendo a
+// regra do tipo da pergunta. Em perguntas de escolha única, marcar uma opção
+// desmarca as demais, como SetCorrectOption. Desmarcar a última opção correta
+// é rejeitado em ambos os tipos.
+//
+// Em caso de erro retorna ErrOptionNotFound ou ErrInvalidCorrectOptions.
+func (q *Question) ToggleCorrect(optionID string) error {
+	option, found := q.FindOption(optionID)
 	if !found {
 		return ErrOptionNotFound
 	}
+	markCorrect := !option.IsCorrect
+	exclusive := markCorrect && !q.IsMultipleChoice()
+
+	newOptions := q.OptionsView()
+	timestamp := now()
+	for i := range newOptions {
+		switch {
+		case newOptions[i].ID == optionID:
+			newOptions[i].IsCorrect = markCorrect
+			newOptions[i].UpdatedAt = timestamp
+		case exclusive && newOptions[i].IsCorrect:
+			newOptions[i].IsCorrect = false
+			newOptions[i].UpdatedAt = timestamp
+		}
+	}
+
+	if err := validateOptions(newOptions, q.Difficulty, q.Type); err != nil {
+		return err
+	}
+
+	q.Options = newOptions
+	q.touch(timestamp)
+	return nil
+}
 
-	if err := validateOptions(q.Options, q.Difficulty); err != nil {
+// SetMedia define a mídia (imagem, áudio etc.) associada à pergunta.
+//
+// Em caso de erro retorna ErrIncompleteMedia ou ErrInvalidMediaURL.
+func (q *Question) SetMedia(mediaURL, mediaType string) error {
+	if err := validateMedia(mediaURL, mediaType); err != nil {
 		return err
 	}
+	q.MediaURL = mediaURL
+	q.MediaType = mediaType
+	q.touch(now())
+	return nil
+}
+
+// RemoveMedia remove a mídia associada à pergunta.
+func (q *Question) RemoveMedia() {
+	q.MediaURL = ""
+	q.MediaType = ""
+	q.touch(now())
+}
+
+// HasMedia verifica se a pergunta possui mídia associada
+func (q *Question) HasMedia() bool {
+	return q.MediaURL != "" && q.MediaType != ""
+}
+
+// TimeLimit retorna o tempo sugerido para responder à pergunta, de acordo
+// com a sua dificuldade.
+func (q *Question) TimeLimit() time.Duration {
+	return q.Difficulty.SuggestedTimeLimit()
+}
+
+// Parâmetros de EstimatedReadingTime.
+const (
+	// DefaultWordsPerMinute é a velocidade de leitura usada quando nenhuma
+	// velocidade válida é informada.
+	DefaultWordsPerMinute = 200
+	// MinReadingTime é o tempo de leitura mínimo estimado para uma pergunta.
+	MinReadingTime = 3 * time.Second
+)
+
+// EstimatedReadingTime estima o tempo de leitura da pergunta a partir da
+// quantidade de palavras do conteúdo e de todas as opções, na velocidade de
+// wordsPerMinute palavras por minuto (DefaultWordsPerMinute para valores
+// menores ou iguais a zero).
+//
+// Retorna no mínimo MinReadingTime.
+func (q *Question) EstimatedReadingTime(wordsPerMinute int) time.Duration {
+	if wordsPerMinute <= 0 {
+		wordsPerMinute = DefaultWordsPerMinute
+	}
+
+	words := len(strings.Fields(q.Content))
+	for _, opt := range q.Options {
+		words += len(strings.Fields(opt.Content))
+	}
+
+	estimate := time.Duration(words) * time.Minute / time.Duration(wordsPerMinute)
+	return max(estimate, MinReadingTime)
+}
+
+// OptionsView retorna uma cópia das opções da pergunta. Alterações na cópia
+// não afetam a pergunta.
+func (q *Question) OptionsView() []Option {
+	view := make([]Option, len(q.Options))
+	copy(view, q.Options)
+	return view
+}
+
+// ShuffledOptions retorna uma cópia das opções da pergunta em ordem aleatória,
+// mantendo as opções fixas (Pinned) no final, na ordem relativa original. A
+// mesma semente sempre produz a mesma ordem.
+func (q *Question) ShuffledOptions(seed int64) []Option {
+	shuffled := make([]Option, 0, len(q.Options))
+	var pinned []Option
+	for _, opt := range q.Options {
+		if opt.Pinned {
+			pinned = append(pinned, opt)
+		} else {
+			shuffled = append(shuffled, opt)
+		}
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	return append(shuffled, pinned...)
+}
+
+// OptionCount retorna a quantidade de opções da pergunta.
+func (q *Question) OptionCount() int {
+	return len(q.Options)
+}
+
+// FindOption busca uma opção da pergunta pelo ID.
+//
+// Retorna um ponteiro para o elemento em q.Options, permitindo edições no
+// lugar. Após alterar a opção retornada, chame Validate para garantir que a
+// pergunta continua válida.
+func (q *Question) FindOption(optionID string) (*Option, bool) {
+	for i := range q.Options {
+		if q.Options[i].ID == optionID {
+			return &q.Options[i], true
+		}
+	}
+	return nil, false
+}
+
+// FindOptionByContent busca uma opção da pergunta pelo conteúdo, ignorando
+// espaços nas extremidades e maiúsculas e minúsculas.
+//
+// Retorna um ponteiro para o elemento em q.Options, permitindo edições no
+// lugar. Após alterar a opção retornada, chame Validate para garantir que a
+// pergunta continua válida.
+func (q *Question) FindOptionByContent(content string) (*Option, bool) {
+	key := normalizeOptionContent(content)
+	for i := range q.Options {
+		if normalizeOptionContent(q.Options[i].Content) == key {
+			return &q.Options[i], true
+		}
+	}
+	return nil, false
+}
 
-	q.UpdatedAt = now
+// hasAmbiguousOptions verifica se há opções com o mesmo conteúdo normalizado e
+// marcações de correta diferentes.
+func hasAmbiguousOptions(options []Option) bool {
+	correctness := make(map[string]bool, len(options))
+	for _, opt := range options {
+		key := normalizeOptionContent(opt.Content)
+		if isCorrect, seen := correctness[key]; seen && isCorrect != opt.IsCorrect {
+			return true
+		}
+		correctness[key] = opt.IsCorrect
+	}
+	return false
+}
+
+// HasAmbiguousOptions verifica se a pergunta possui opções com o mesmo
+// conteúdo (ignorando espaços nas extremidades e maiúsculas e minúsculas),
+// sendo uma correta e outra incorreta.
+func (q *Question) HasAmbiguousOptions() bool {
+	return hasAmbiguousOptions(q.Options)
+}
+
+// HasDuplicateOptions verifica se a pergunta possui opções com conteúdo
+// duplicado, ignorando espaços nas extremidades e maiúsculas e minúsculas.
+func (q *Question) HasDuplicateOptions() bool {
+	return hasDuplicateOptions(q.Options)
+}
+
+// DeduplicateOptions remove as opções com conteúdo duplicado, mantendo a
+// primeira ocorrência na sua posição original. Se uma duplicata posterior for
+// a opção correta, ela substitui a primeira ocorrência.
+//
+// Retorna a quantidade de opções removidas.
+func (q *Question) DeduplicateOptions() int {
+	index := make(map[string]int, len(q.Options))
+	var newOptions []Option
+
+	for _, opt := range q.Options {
+		key := normalizeOptionContent(opt.Content)
+		if i, ok := index[key]; ok {
+			if opt.IsCorrect && !newOptions[i].IsCorrect {
+				newOptions[i] = opt
+			}
+			continue
+		}
+		index[key] = len(newOptions)
+		newOptions = append(newOptions, opt)
+	}
+
+	removed := len(q.Options) - len(newOptions)
+	if removed > 0 {
+		q.Options = newOptions
+		q.touch(now())
+	}
+	return removed
+}
+
+// CheckIntegrity verifica a consistência da pergunta e de suas opções: a
+// pergunta deve ser válida, toda opção deve referenciar a pergunta e os IDs das
+// opções devem ser únicos.
+//
+// Em caso de erro retorna ValidationError que contém todas as inconsistências encontradas.
+func (q *Question) CheckIntegrity() error {
+	ve := &ValidationError{}
+
+	ve.addAll(q.Validate())
+
+	seen := make(map[string]bool, len(q.Options))
+	for _, opt := range q.Options {
+		if opt.QuestionID != q.ID {
+			ve.Add(fmt.Errorf("option %q: %w", opt.ID, ErrOptionQuestionIDMismatch))
+		}
+
+		if seen[opt.ID] {
+			ve.Add(fmt.Errorf("option %q: %w", opt.ID, ErrDuplicateOptionID))
+		}
+		seen[opt.ID] = true
+	}
+
+	if ve.HasErrors() {
+		return ve
+	}
 	return nil
 }
 
+// ShiftDifficulty retorna cópias das perguntas com a dificuldade deslocada em
+// delta níveis (positivo sobe, negativo desce), limitada entre VeryEasy e
+// VeryHard.
+//
+// Perguntas cujas opções excedem a nova dificuldade mantêm a dificuldade
+// original; as demais são alteradas normalmente.
+//
+// Em caso de erro retorna ValidationError com um ErrChangeDifficulty por pergunta que não pôde ser alterada.
+func ShiftDifficulty(qs []Question, delta int) ([]Question, error) {
+	ve := &ValidationError{}
+	shifted := make([]Question, len(qs))
+
+	for i := range qs {
+		q := qs[i]
+		q.Options = qs[i].OptionsView()
+
+		target := q.Difficulty
+		for step := 0; step < delta; step++ {
+			target = target.Next()
+		}
+		for step := 0; step > delta; step-- {
+			target = target.Previous()
+		}
+
+		if target != q.Difficulty {
+			if err := q.UpdateDifficulty(target); err != nil {
+				ve.Add(fmt.Errorf("question %q: %w", q.ID, err))
+			}
+		}
+		shifted[i] = q
+	}
+
+	if ve.HasErrors() {
+		return shifted, ve
+	}
+	return shifted, nil
+}
+
+// HasTag verifica se a pergunta possui a tag informada, ignorando
+// maiúsculas, minúsculas e acentos.
+func (q *Question) HasTag(tag string) bool {
+	key := foldText(tag)
+	for _, t := range q.Tags {
+		if foldText(t) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// QuestionFilter define critérios de busca de perguntas. Apenas os critérios
+// definidos (não nulos ou não vazios) são aplicados.
+type QuestionFilter struct {
+	SubjectID       string
+	Difficulty      *Difficulty
+	Tag             string
+	ContentContains string
+}
+
+// Matches verifica se a pergunta atende a todos os critérios definidos no
+// filtro. Disciplina e dificuldade são comparadas exatamente; a busca por
+// conteúdo ignora maiúsculas, minúsculas e acentos.
+func (f QuestionFilter) Matches(q *Question) bool {
+	if f.SubjectID != "" && q.SubjectID != f.SubjectID {
+		return false
+	}
+
+	if f.Difficulty != nil && q.Difficulty != *f.Difficulty {
+		return false
+	}
+
+	if f.Tag != "" && !q.HasTag(f.Tag) {
+		return false
+	}
+
+	if f.ContentContains != "" && !strings.Contains(foldText(q.Content), foldText(f.ContentContains)) {
+		return false
+	}
+
+	return true
+}
+
+// Simplify cria uma variante mais fácil da pergunta com a dificuldade
+// informada, mantendo a opção correta e os primeiros distratores até o limite
+// de targetDifficulty.MaxOptions(). A variante recebe novos IDs, gerados com
+// pkg.NewID.
+//
+// Em caso de erro retorna ErrInvalidDifficulty, ErrSimplifyDifficulty ou ValidationError.
+func (q *Question) Simplify(targetDifficulty Difficulty) (*Question, error) {
+	if err := validateDifficulty(targetDifficulty); err != nil {
+		return nil, err
+	}
+	if targetDifficulty > q.Difficulty {
+		return nil, ErrSimplifyDifficulty
+	}
+
+	questionID, err := pkg.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("[model.Question.Simplify] ERROR: %w", err)
+	}
+
+	distractors := targetDifficulty.MaxOptions() - 1
+	options := make([]Option, 0, targetDifficulty.MaxOptions())
+	for _, opt := range q.Options {
+		if !opt.IsCorrect {
+			if distractors == 0 {
+				continue
+			}
+			distractors--
+		}
+
+		clone := opt.Clone()
+		if clone.ID, err = pkg.NewID(); err != nil {
+			return nil, fmt.Errorf("[model.Question.Simplify] ERROR: %w", err)
+		}
+		clone.QuestionID = questionID
+		options = append(options, clone)
+	}
+
+	timestamp := now()
+	simplified := &Question{
+		ID:         questionID,
+		SubjectID:  q.SubjectID,
+		Content:    q.Content,
+		Difficulty: targetDifficulty,
+		Type:       q.Type,
+		Options:    options,
+		Tags:       slices.Clone(q.Tags),
+		MediaURL:   q.MediaURL,
+		MediaType:  q.MediaType,
+		Version:    1,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
+	}
+
+	if err := simplified.Validate(); err != nil {
+		return nil, err
+	}
+	return simplified, nil
+}
+
+// histogramWidth é a largura máxima, em caracteres, das barras do histograma.
+const histogramWidth = 40
+
+// DifficultyDistribution conta as perguntas por nível de dificuldade.
+func DifficultyDistribution(qs []Question) map[Difficulty]int {
+	distribution := make(map[Difficulty]int)
+	for i := range qs {
+		distribution[qs[i].Difficulty]++
+	}
+	return distribution
+}
+
+// DifficultyHistogram retorna um gráfico de barras em texto com a quantidade
+// de perguntas por nível de dificuldade, de VeryEasy a VeryHard, com as barras
+// escaladas para no máximo histogramWidth caracteres. Os rótulos (ver
+// Difficulty.String) são alinhados pelo mais longo.
+func DifficultyHistogram(qs []Question) string {
+	distribution := DifficultyDistribution(qs)
+
+	maxCount := 0
+	labelWidth := 0
+	labels := make([]string, len(difficultyLevels))
+	for i, d := range difficultyLevels {
+		maxCount = max(maxCount, distribution[d])
+		labels[i] = d.String()
+		labelWidth = max(labelWidth, utf8.RuneCountInString(labels[i]))
+	}
+
+	var sb strings.Builder
+	for i, d := range difficultyLevels {
+		count := distribution[d]
+		width := 0
+		if maxCount > 0 {
+			width = count * histogramWidth / maxCount
+		}
+		if count > 0 && width == 0 {
+			width = 1
+		}
+		fmt.Fprintf(&sb, "%-*s | %s %d\n", labelWidth, labels[i], strings.Repeat("█", width), count)
+	}
+	return sb.String()
+}
+
+// CanonicalJSON retorna uma representação em JSON estável da pergunta, sem os
+// campos voláteis: IDs (da pergunta e das opções), timestamps, Version e
+// Published são zerados e as opções são ordenadas pelo conteúdo e pela
+// correção. Perguntas com o mesmo conteúdo produzem o mesmo JSON, mesmo com IDs
+// diferentes.
+//
+// Em caso de erro retorna o erro de serialização.
+func (q *Question) CanonicalJSON() ([]byte, error) {
+	canonical := *q
+	canonical.ID = ""
+	canonical.Version = 0
+	canonical.Published = false
+	canonical.CreatedAt = time.Time{}
+	canonical.UpdatedAt = time.Time{}
+	canonical.Options = q.OptionsView()
+
+	for i := range canonical.Options {
+		canonical.Options[i].ID = ""
+		canonical.Options[i].QuestionID = ""
+		canonical.Options[i].CreatedAt = time.Time{}
+		canonical.Options[i].UpdatedAt = time.Time{}
+	}
+	slices.SortFunc(canonical.Options, func(a, b Option) int {
+		if c := strings.Compare(a.Content, b.Content); c != 0 {
+			return c
+		}
+		switch {
+		case a.IsCorrect == b.IsCorrect:
+			return 0
+		case a.IsCorrect:
+			return 1
+		default:
+			return -1
+		}
+	})
+
+	data, err := json.Marshal(canonical)
+	if err != nil {
+		return nil, fmt.Errorf("[model.Question.CanonicalJSON] ERROR: %w", err)
+	}
+	return data, nil
+}
+
+// ContentHash retorna o hash SHA-256, em hexadecimal, de CanonicalJSON.
+//
+// Em caso de erro de serialização retorna uma string vazia.
+func (q *Question) ContentHash() string {
+	data, err := q.CanonicalJSON()
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // String retorna a representação em JSON da pergunta
 func (q *Question) String() string {
 	data, err := json.MarshalIndent(q, "", "  ")
@@ -240,3 +1062,38 @@ func (q *Question) String() string {
 	}
 	return string(data)
 }
+
+// Equal verifica se duas perguntas são semanticamente iguais.
+//
+// Compara conteúdo, dificuldade, tipo, disciplina, mídia, tags e as opções em
+// ordem, ignorando IDs das opções e timestamps.
+func (q *Question) Equal(other *Question) bool {
+	if q == nil || other == nil {
+		return q == other
+	}
+
+	return q.Content == other.Content &&
+		q.Difficulty == other.Difficulty &&
+		q.Type == other.Type &&
+		q.SubjectID == other.SubjectID &&
+		q.MediaURL == other.MediaURL &&
+		q.MediaType == other.MediaType &&
+		slices.Equal(q.Tags, other.Tags) &&
+		OptionsEqual(q.Options, other.Options)
+}
+
+// OptionsEqual verifica se duas listas de opções são iguais, na mesma ordem,
+// comparando apenas conteúdo, correção, fixação e feedback.
+func OptionsEqual(a, b []Option) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Content != b[i].Content || a[i].IsCorrect != b[i].IsCorrect ||
+			a[i].Pinned != b[i].Pinned || a[i].Feedback != b[i].Feedback {
+			return false
+		}
+	}
+	return true
+}
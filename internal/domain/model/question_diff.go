@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FieldChange descreve a alteração de um campo entre duas versões de uma
+// pergunta.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// DiffQuestions lista as alterações entre duas versões de uma pergunta:
+// disciplina, conteúdo, dificuldade, tipo, tags, mídia, publicação e opções
+// adicionadas, removidas ou modificadas (identificadas pelo ID). Timestamps são
+// ignorados.
+//
+// As opções são reportadas no campo "options[<id>]"; o valor vazio indica
+// opção adicionada (Old) ou removida (New).
+func DiffQuestions(old, new *Question) []FieldChange {
+	var changes []FieldChange
+	addChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	addChange("subjectId", old.SubjectID, new.SubjectID)
+	addChange("content", old.Content, new.Content)
+	addChange("difficulty", old.Difficulty.canonicalLabel(), new.Difficulty.canonicalLabel())
+	addChange("type", string(old.Type), string(new.Type))
+	addChange("tags", strings.Join(old.Tags, ", "), strings.Join(new.Tags, ", "))
+	addChange("mediaUrl", old.MediaURL, new.MediaURL)
+	addChange("mediaType", old.MediaType, new.MediaType)
+	addChange("published", strconv.FormatBool(old.Published), strconv.FormatBool(new.Published))
+
+	for _, opt := range old.Options {
+		newValue := ""
+		if newOpt, found := new.FindOption(opt.ID); found {
+			newValue = describeOption(*newOpt)
+		}
+		addChange(optionField(opt.ID), describeOption(opt), newValue)
+	}
+
+	for _, opt := range new.Options {
+		if !slices.ContainsFunc(old.Options, func(o Option) bool { return o.ID == opt.ID }) {
+			addChange(optionField(opt.ID), "", describeOption(opt))
+		}
+	}
+
+	return changes
+}
+
+// optionField retorna o nome do campo usado para reportar a opção.
+func optionField(optionID string) string {
+	return fmt.Sprintf("options[%s]", optionID)
+}
+
+// describeOption retorna uma descrição legível da opção.
+func describeOption(opt Option) string {
+	description := opt.Content
+	if opt.IsCorrect {
+		description += " (correct)"
+	}
+	if opt.Pinned {
+		description += " (pinned)"
+	}
+	if opt.Feedback != "" {
+		description += " [feedback: " + opt.Feedback + "]"
+	}
+	return description
+}
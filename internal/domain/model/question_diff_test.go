@@ -0,0 +1,69 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffQuestions(t *testing.T) {
+	old := newTestQuestion(t, Medium, "42", "24")
+
+	tests := []struct {
+		name   string
+		mutate func(q *Question)
+		want   []FieldChange
+	}{
+		{name: "no changes", mutate: func(*Question) {}},
+		{
+			name: "difficulty change and added option",
+			mutate: func(q *Question) {
+				q.Difficulty = Hard
+				q.Options = append(q.Options, Option{ID: testID(1500), QuestionID: q.ID, Content: "12"})
+			},
+			want: []FieldChange{
+				{Field: "difficulty", Old: "Medium", New: "Hard"},
+				{Field: "options[" + testID(1500) + "]", New: "12"},
+			},
+		},
+		{
+			name: "removed and modified options",
+			mutate: func(q *Question) {
+				q.Options = []Option{q.Options[0]}
+				q.Options[0].Feedback = "Correct!"
+			},
+			want: []FieldChange{
+				{Field: "options[" + testID(1001) + "]", Old: "42 (correct)", New: "42 (correct) [feedback: Correct!]"},
+				{Field: "options[" + testID(1002) + "]", Old: "24"},
+			},
+		},
+		{
+			name: "media and tags",
+			mutate: func(q *Question) {
+				q.Tags = []string{"math"}
+				q.MediaURL = "https://example.com/a.png"
+				q.MediaType = "image/png"
+			},
+			want: []FieldChange{
+				{Field: "tags", New: "math"},
+				{Field: "mediaUrl", New: "https://example.com/a.png"},
+				{Field: "mediaType", New: "image/png"},
+			},
+		},
+		{
+			name:   "timestamps and version are ignored",
+			mutate: func(q *Question) { q.touch(q.UpdatedAt.Add(1)) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := *old
+			updated.Options = old.OptionsView()
+			tt.mutate(&updated)
+
+			if got := DiffQuestions(old, &updated); !slices.Equal(got, tt.want) {
+				t.Errorf("DiffQuestions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
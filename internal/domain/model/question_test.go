@@ -0,0 +1,1003 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"educational-reinforcement-platform/pkg"
+)
+
+// testID retorna um UUID v7 válido e determinístico para os testes.
+func testID(n int) string {
+	return fmt.Sprintf("00000000-0000-7000-8000-%012x", n)
+}
+
+// newTestQuestion cria uma pergunta válida de escolha única com uma opção por
+// conteúdo informado. A primeira opção é a correta.
+func newTestQuestion(t *testing.T, difficulty Difficulty, contents ...string) *Question {
+	t.Helper()
+
+	questionID := testID(1000)
+	options := make([]Option, 0, len(contents))
+	for i, content := range contents {
+		option, err := NewOption(testID(1001+i), questionID, content, i == 0)
+		if err != nil {
+			t.Fatalf("NewOption(%q) error = %v", content, err)
+		}
+		options = append(options, *option)
+	}
+
+	question, err := NewQuestion(questionID, testID(2000), "What is 6 x 7?", difficulty, options)
+	if err != nil {
+		t.Fatalf("NewQuestion() error = %v", err)
+	}
+	return question
+}
+
+func TestQuestionSetMedia(t *testing.T) {
+	tests := []struct {
+		name      string
+		mediaURL  string
+		mediaType string
+		wantErr   error
+	}{
+		{name: "https URL", mediaURL: "https://cdn.example.com/q/1.png", mediaType: "image/png"},
+		{name: "http URL", mediaURL: "http://cdn.example.com/q/1.mp3", mediaType: "audio/mpeg"},
+		{name: "javascript URL", mediaURL: "javascript:alert(1)", mediaType: "image/png", wantErr: ErrInvalidMediaURL},
+		{name: "relative path", mediaURL: "/x.png", mediaType: "image/png", wantErr: ErrInvalidMediaURL},
+		{name: "unsupported scheme", mediaURL: "ftp://cdn.example.com/1.png", mediaType: "image/png", wantErr: ErrInvalidMediaURL},
+		{name: "missing host", mediaURL: "https:///1.png", mediaType: "image/png", wantErr: ErrInvalidMediaURL},
+		{name: "URL without type", mediaURL: "https://cdn.example.com/q/1.png", wantErr: ErrIncompleteMedia},
+		{name: "type without URL", mediaType: "image/png", wantErr: ErrIncompleteMedia},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41", "43")
+
+			err := q.SetMedia(tt.mediaURL, tt.mediaType)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetMedia() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && q.HasMedia() {
+				t.Errorf("SetMedia() stored media after error")
+			}
+			if tt.wantErr == nil && q.MediaURL != tt.mediaURL {
+				t.Errorf("MediaURL = %q, want %q", q.MediaURL, tt.mediaURL)
+			}
+		})
+	}
+}
+
+// correctOptionIDs retorna os IDs das opções corretas da pergunta, em ordem.
+func correctOptionIDs(q *Question) []string {
+	var ids []string
+	for _, opt := range q.Options {
+		if opt.IsCorrect {
+			ids = append(ids, opt.ID)
+		}
+	}
+	return ids
+}
+
+func TestQuestionToggleCorrect(t *testing.T) {
+	first, second, third := testID(1001), testID(1002), testID(1003)
+
+	tests := []struct {
+		name        string
+		multiple    bool
+		toggles     []string
+		wantErr     error
+		wantCorrect []string
+	}{
+		{name: "single choice marking a distractor moves the answer", toggles: []string{second}, wantCorrect: []string{second}},
+		{name: "single choice unmarking the only correct option", toggles: []string{first}, wantErr: ErrInvalidCorrectOptions, wantCorrect: []string{first}},
+		{name: "single choice unknown option", toggles: []string{testID(9999)}, wantErr: ErrOptionNotFound, wantCorrect: []string{first}},
+		{name: "multiple choice marking a distractor adds an answer", multiple: true, toggles: []string{third}, wantCorrect: []string{first, third}},
+		{name: "multiple choice unmarking one of two correct options", multiple: true, toggles: []string{second, first}, wantCorrect: []string{second}},
+		{name: "multiple choice unmarking the last correct option", multiple: true, toggles: []string{first}, wantErr: ErrInvalidCorrectOptions, wantCorrect: []string{first}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41", "43")
+			if tt.multiple {
+				if err := q.UpdateType(QuestionTypeMultipleChoice); err != nil {
+					t.Fatalf("UpdateType() error = %v", err)
+				}
+			}
+
+			var err error
+			for _, id := range tt.toggles {
+				if err = q.ToggleCorrect(id); err != nil {
+					break
+				}
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ToggleCorrect() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := correctOptionIDs(q); !slices.Equal(got, tt.wantCorrect) {
+				t.Errorf("correct options = %v, want %v", got, tt.wantCorrect)
+			}
+			if err := q.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestQuestionContentHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(q *Question)
+		wantSame bool
+	}{
+		{
+			name:     "reordered options",
+			mutate:   func(q *Question) { slices.Reverse(q.Options) },
+			wantSame: true,
+		},
+		{
+			name: "fresh IDs",
+			mutate: func(q *Question) {
+				q.ID = testID(5000)
+				for i := range q.Options {
+					q.Options[i].ID = testID(5001 + i)
+					q.Options[i].QuestionID = q.ID
+				}
+			},
+			wantSame: true,
+		},
+		{
+			name: "volatile fields",
+			mutate: func(q *Question) {
+				q.Version = 7
+				q.Published = true
+				q.UpdatedAt = q.UpdatedAt.Add(time.Hour)
+				q.Options[0].UpdatedAt = q.Options[0].UpdatedAt.Add(time.Hour)
+			},
+			wantSame: true,
+		},
+		{
+			name:   "changed content",
+			mutate: func(q *Question) { q.Content = "What is 6 x 8?" },
+		},
+		{
+			name: "changed correct option",
+			mutate: func(q *Question) {
+				q.Options[0].IsCorrect = false
+				q.Options[1].IsCorrect = true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := newTestQuestion(t, Easy, "42", "41", "43")
+			changed := newTestQuestion(t, Easy, "42", "41", "43")
+			tt.mutate(changed)
+
+			if same := original.ContentHash() == changed.ContentHash(); same != tt.wantSame {
+				t.Errorf("same hash = %v, want %v", same, tt.wantSame)
+			}
+		})
+	}
+}
+
+// renumberQuestion altera o ID da pergunta para testID(n) e os IDs das opções
+// para testID(n+1), testID(n+2) etc.
+func renumberQuestion(q *Question, n int) *Question {
+	q.ID = testID(n)
+	for i := range q.Options {
+		q.Options[i].ID = testID(n + 1 + i)
+		q.Options[i].QuestionID = q.ID
+	}
+	return q
+}
+
+// useSequentialIDs instala um pkg.SequentialGenerator como gerador padrão
+// durante o teste.
+func useSequentialIDs(t *testing.T) {
+	t.Helper()
+	pkg.SetDefaultIDGenerator(&pkg.SequentialGenerator{})
+	t.Cleanup(func() { pkg.SetDefaultIDGenerator(nil) })
+}
+
+func TestQuestionWithOptions(t *testing.T) {
+	useSequentialIDs(t)
+	q := newTestQuestion(t, Easy, "42", "41")
+
+	_, err := q.WithOptions(
+		Option{ID: testID(3001), QuestionID: testID(9999), Content: "42", IsCorrect: true},
+		Option{QuestionID: testID(9999), Content: "41"},
+		Option{Content: "43"},
+	)
+	if err != nil {
+		t.Fatalf("WithOptions() error = %v", err)
+	}
+
+	wantIDs := []string{testID(3001), testID(1), testID(2)}
+	for i, opt := range q.Options {
+		if opt.QuestionID != q.ID {
+			t.Errorf("Options[%d].QuestionID = %q, want %q", i, opt.QuestionID, q.ID)
+		}
+		if opt.ID != wantIDs[i] {
+			t.Errorf("Options[%d].ID = %q, want %q", i, opt.ID, wantIDs[i])
+		}
+	}
+	if err := q.CheckIntegrity(); err != nil {
+		t.Errorf("CheckIntegrity() error = %v", err)
+	}
+}
+
+func TestQuestionSimplify(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      Difficulty
+		wantErr     error
+		wantOptions []string
+	}{
+		{name: "keeps correct option and first distractors", target: Easy, wantOptions: []string{"42", "41", "43"}},
+		{name: "same difficulty", target: Hard, wantOptions: []string{"42", "41", "43", "44", "45"}},
+		{name: "harder target", target: VeryHard, wantErr: ErrSimplifyDifficulty},
+		{name: "invalid target", target: Difficulty(1), wantErr: ErrInvalidDifficulty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useSequentialIDs(t)
+			q := newTestQuestion(t, Hard, "42", "41", "43", "44", "45")
+
+			simplified, err := q.Simplify(tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Simplify() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if simplified.ID != testID(1) {
+				t.Errorf("ID = %q, want %q", simplified.ID, testID(1))
+			}
+			var contents []string
+			for i, opt := range simplified.Options {
+				contents = append(contents, opt.Content)
+				if want := testID(2 + i); opt.ID != want {
+					t.Errorf("Options[%d].ID = %q, want %q", i, opt.ID, want)
+				}
+			}
+			if !slices.Equal(contents, tt.wantOptions) {
+				t.Errorf("options = %v, want %v", contents, tt.wantOptions)
+			}
+			if len(q.Options) != 5 || q.Options[0].ID != testID(1001) {
+				t.Errorf("Simplify() changed the original question")
+			}
+		})
+	}
+}
+
+func TestQuestionEqualMedia(t *testing.T) {
+	original := newTestQuestion(t, Easy, "42", "41", "43")
+	edited := newTestQuestion(t, Easy, "42", "41", "43")
+	if err := edited.SetMedia("https://cdn.example.com/q/1.png", "image/png"); err != nil {
+		t.Fatalf("SetMedia() error = %v", err)
+	}
+
+	if original.Equal(edited) {
+		t.Errorf("Equal() = true for questions with different media")
+	}
+
+	changes := DiffQuestions(original, edited)
+	var fields []string
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	if want := []string{"mediaUrl", "mediaType"}; !slices.Equal(fields, want) {
+		t.Errorf("DiffQuestions() fields = %v, want %v", fields, want)
+	}
+}
+
+func TestQuestionEqualTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		tags      []string
+		otherTags []string
+		want      bool
+	}{
+		{name: "no tags", want: true},
+		{name: "nil and empty", otherTags: []string{}, want: true},
+		{name: "same tags", tags: []string{"math", "álgebra"}, otherTags: []string{"math", "álgebra"}, want: true},
+		{name: "added tag", tags: []string{"math"}, otherTags: []string{"math", "álgebra"}},
+		{name: "renamed tag", tags: []string{"math"}, otherTags: []string{"maths"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41", "43")
+			other := newTestQuestion(t, Easy, "42", "41", "43")
+			q.Tags, other.Tags = tt.tags, tt.otherTags
+
+			if got := q.Equal(other); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionFilterMatches(t *testing.T) {
+	easy, medium := Easy, Medium
+
+	tests := []struct {
+		name   string
+		filter QuestionFilter
+		want   bool
+	}{
+		{name: "empty filter", want: true},
+		{name: "tag ignoring case and accents", filter: QuestionFilter{Tag: "ALGEBRA"}, want: true},
+		{name: "difficulty and tag", filter: QuestionFilter{Difficulty: &easy, Tag: "arithmetic"}, want: true},
+		{name: "matching tag with other difficulty", filter: QuestionFilter{Difficulty: &medium, Tag: "arithmetic"}},
+		{name: "unknown tag", filter: QuestionFilter{Tag: "geometry"}},
+		{name: "subject and content", filter: QuestionFilter{SubjectID: testID(2000), ContentContains: "6 X 7"}, want: true},
+		{name: "other subject", filter: QuestionFilter{SubjectID: testID(2001)}},
+	}
+
+	q := newTestQuestion(t, Easy, "42", "41", "43")
+	q.Tags = []string{"Álgebra", "arithmetic"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(q); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(q *Question)
+		want   bool
+	}{
+		{name: "only UpdatedAt differs", mutate: func(q *Question) { q.UpdatedAt = q.UpdatedAt.Add(time.Hour) }, want: true},
+		{
+			name: "option IDs and timestamps differ",
+			mutate: func(q *Question) {
+				q.Options[0].ID = testID(9999)
+				q.Options[0].CreatedAt = q.Options[0].CreatedAt.Add(time.Hour)
+			},
+			want: true,
+		},
+		{name: "content differs", mutate: func(q *Question) { q.Content = "What is 7 x 7?" }},
+		{name: "difficulty differs", mutate: func(q *Question) { q.Difficulty = Medium }},
+		{name: "subject differs", mutate: func(q *Question) { q.SubjectID = testID(2001) }},
+		{name: "options reordered", mutate: func(q *Question) { slices.Reverse(q.Options) }},
+		{name: "correct option differs", mutate: func(q *Question) { q.Options[0].IsCorrect, q.Options[1].IsCorrect = false, true }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41", "43")
+			other := newTestQuestion(t, Easy, "42", "41", "43")
+			tt.mutate(other)
+
+			if got := q.Equal(other); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var nilQuestion *Question
+	if !nilQuestion.Equal(nil) || nilQuestion.Equal(newTestQuestion(t, Easy, "42", "41")) {
+		t.Errorf("Equal() with nil questions is inconsistent")
+	}
+}
+
+func TestQuestionDeduplicateOptions(t *testing.T) {
+	option := func(n int, content string, isCorrect bool) Option {
+		return Option{ID: testID(n), QuestionID: testID(1000), Content: content, IsCorrect: isCorrect}
+	}
+
+	tests := []struct {
+		name        string
+		options     []Option
+		wantRemoved int
+		wantIDs     []string
+	}{
+		{
+			name:        "two identical distractors",
+			options:     []Option{option(1, "42", true), option(2, "41", false), option(3, " 41 ", false), option(4, "43", false)},
+			wantRemoved: 1,
+			wantIDs:     []string{testID(1), testID(2), testID(4)},
+		},
+		{
+			name:        "correct duplicate replaces the first occurrence",
+			options:     []Option{option(1, "Paris", false), option(2, "Rome", false), option(3, "paris", true)},
+			wantRemoved: 1,
+			wantIDs:     []string{testID(3), testID(2)},
+		},
+		{
+			name:    "no duplicates",
+			options: []Option{option(1, "42", true), option(2, "41", false)},
+			wantIDs: []string{testID(1), testID(2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Question{ID: testID(1000), Difficulty: Medium, Options: tt.options, Version: 1}
+			wantVersion := 1
+			if tt.wantRemoved > 0 {
+				wantVersion = 2
+			}
+
+			if got := q.DeduplicateOptions(); got != tt.wantRemoved {
+				t.Errorf("DeduplicateOptions() = %d, want %d", got, tt.wantRemoved)
+			}
+
+			var ids []string
+			for _, opt := range q.Options {
+				ids = append(ids, opt.ID)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("option IDs = %v, want %v", ids, tt.wantIDs)
+			}
+			if q.HasDuplicateOptions() {
+				t.Errorf("HasDuplicateOptions() = true after deduplication")
+			}
+			if q.Version != wantVersion {
+				t.Errorf("Version = %d, want %d", q.Version, wantVersion)
+			}
+		})
+	}
+}
+
+func TestQuestionCheckIntegrity(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(q *Question)
+		wantErrs []error
+	}{
+		{name: "consistent", mutate: func(q *Question) {}},
+		{
+			name:     "option from another question",
+			mutate:   func(q *Question) { q.Options[1].QuestionID = testID(9999) },
+			wantErrs: []error{ErrOptionQuestionIDMismatch},
+		},
+		{
+			name:     "duplicate option IDs",
+			mutate:   func(q *Question) { q.Options[2].ID = q.Options[1].ID },
+			wantErrs: []error{ErrDuplicateOptionID},
+		},
+		{
+			name: "invalid question and inconsistent option",
+			mutate: func(q *Question) {
+				q.Content = ""
+				q.Options[0].QuestionID = ""
+			},
+			wantErrs: []error{ErrEmptyQuestionContent, ErrOptionQuestionIDMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41", "43")
+			tt.mutate(q)
+
+			err := q.CheckIntegrity()
+			if (err != nil) != (len(tt.wantErrs) > 0) {
+				t.Fatalf("CheckIntegrity() error = %v", err)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("CheckIntegrity() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestQuestionValidateWithMinOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []string
+		constraints QuestionConstraints
+		wantErr     error
+	}{
+		{name: "two options with default constraints", options: []string{"42", "41"}},
+		{name: "two options with MinOptions 3", options: []string{"42", "41"}, constraints: QuestionConstraints{MinOptions: 3}, wantErr: ErrQuantityOptions},
+		{name: "three options with MinOptions 3", options: []string{"42", "41", "43"}, constraints: QuestionConstraints{MinOptions: 3}},
+		{name: "MinOptions below the default", options: []string{"42", "41"}, constraints: QuestionConstraints{MinOptions: 1}},
+		{name: "MinOptions above the difficulty maximum", options: []string{"42", "41", "43"}, constraints: QuestionConstraints{MinOptions: 4}, wantErr: ErrImpossibleMinOptions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, tt.options...)
+			if err := q.ValidateWith(tt.constraints); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateWith() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionUpdateContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr error
+	}{
+		{name: "trims and collapses spaces", content: "  What   is\t6 x 7?  ", want: "What is 6 x 7?"},
+		{name: "keeps line breaks but drops blank lines", content: "Line one\n\n\n  Line   two ", want: "Line one\nLine two"},
+		{name: "at the limit", content: strings.Repeat("é", MaxQuestionContentLength), want: strings.Repeat("é", MaxQuestionContentLength)},
+		{name: "over the limit", content: strings.Repeat("a", MaxQuestionContentLength+1), wantErr: ErrQuestionContentTooLong},
+		{name: "whitespace only", content: " \n\t ", wantErr: ErrEmptyQuestionContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion(t, Easy, "42", "41")
+			original := q.Content
+
+			err := q.UpdateContent(tt.content)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateContent() error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := tt.want
+			if tt.wantErr != nil {
+				want = original
+			}
+			if q.Content != want {
+				t.Errorf("Content = %q, want %q", q.Content, want)
+			}
+		})
+	}
+}
+
+func TestQuestionFindOption(t *testing.T) {
+	q := newTestQuestion(t, Easy, "42", "Forty One", "43")
+
+	tests := []struct {
+		name      string
+		find      func() (*Option, bool)
+		wantID    string
+		wantFound bool
+	}{
+		{name: "by ID", find: func() (*Option, bool) { return q.FindOption(testID(1002)) }, wantID: testID(1002), wantFound: true},
+		{name: "unknown ID", find: func() (*Option, bool) { return q.FindOption(testID(9999)) }},
+		{name: "by content ignoring case and spaces", find: func() (*Option, bool) { return q.FindOptionByContent("  forty one ") }, wantID: testID(1002), wantFound: true},
+		{name: "unknown content", find: func() (*Option, bool) { return q.FindOptionByContent("44") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.find()
+			if found != tt.wantFound {
+				t.Fatalf("found = %v, want %v", found, tt.wantFound)
+			}
+			if found && got.ID != tt.wantID {
+				t.Errorf("ID = %q, want %q", got.ID, tt.wantID)
+			}
+		})
+	}
+
+	option, _ := q.FindOption(testID(1003))
+	option.Feedback = "edited in place"
+	if q.Options[2].Feedback != "edited in place" {
+		t.Errorf("FindOption() did not return a pointer into q.Options")
+	}
+}
+
+func TestQuestionOptionsView(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24", "12")
+
+	view := q.OptionsView()
+	view[0].Content = "changed"
+	view[0].IsCorrect = false
+	view = append(view, Option{ID: testID(9999)})
+
+	if got := q.OptionCount(); got != 3 {
+		t.Errorf("OptionCount() = %d, want 3", got)
+	}
+	if q.Options[0].Content != "42" || !q.Options[0].IsCorrect {
+		t.Errorf("Options[0] = %+v, want unchanged", q.Options[0])
+	}
+	if len(view) != 4 {
+		t.Errorf("len(view) = %d, want 4", len(view))
+	}
+}
+
+func TestShiftDifficulty(t *testing.T) {
+	small := *newTestQuestion(t, Medium, "42", "24", "12")
+	full := *newTestQuestion(t, Medium, "42", "24", "12", "6")
+	full.ID = testID(1100)
+	top := *newTestQuestion(t, VeryHard, "42", "24")
+	top.ID = testID(1200)
+
+	tests := []struct {
+		name    string
+		qs      []Question
+		delta   int
+		want    []Difficulty
+		wantErr int
+	}{
+		{name: "up one level", qs: []Question{small, full}, delta: 1, want: []Difficulty{Hard, Hard}},
+		{name: "clamped at VeryHard", qs: []Question{top}, delta: 2, want: []Difficulty{VeryHard}},
+		{name: "options exceed new difficulty", qs: []Question{small, full}, delta: -1, want: []Difficulty{Easy, Medium}, wantErr: 1},
+		{name: "zero delta", qs: []Question{small}, want: []Difficulty{Medium}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := make([]Difficulty, len(tt.qs))
+			for i := range tt.qs {
+				original[i] = tt.qs[i].Difficulty
+			}
+
+			shifted, err := ShiftDifficulty(tt.qs, tt.delta)
+
+			var ve *ValidationError
+			switch {
+			case tt.wantErr == 0 && err != nil:
+				t.Fatalf("ShiftDifficulty() error = %v, want nil", err)
+			case tt.wantErr > 0 && (!errors.As(err, &ve) || ve.Count() != tt.wantErr || !errors.Is(err, ErrChangeDifficulty)):
+				t.Fatalf("ShiftDifficulty() error = %v, want %d ErrChangeDifficulty", err, tt.wantErr)
+			}
+
+			for i, want := range tt.want {
+				if shifted[i].Difficulty != want {
+					t.Errorf("shifted[%d].Difficulty = %v, want %v", i, shifted[i].Difficulty, want)
+				}
+				if tt.qs[i].Difficulty != original[i] {
+					t.Errorf("qs[%d].Difficulty = %v, want unchanged %v", i, tt.qs[i].Difficulty, original[i])
+				}
+			}
+		})
+	}
+}
+
+func TestQuestionAddOptionCopiesOption(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+
+	option := Option{ID: testID(1500), QuestionID: testID(9999), Content: "12"}
+	if err := q.AddOption(option); err != nil {
+		t.Fatalf("AddOption() error = %v", err)
+	}
+	option.Content = "changed"
+	option.IsCorrect = true
+
+	added, found := q.FindOption(testID(1500))
+	if !found {
+		t.Fatalf("FindOption(%q) found = false, want true", testID(1500))
+	}
+	if added.Content != "12" || added.IsCorrect {
+		t.Errorf("added option = %+v, want unaffected by caller changes", *added)
+	}
+	if added.QuestionID != q.ID {
+		t.Errorf("QuestionID = %q, want %q", added.QuestionID, q.ID)
+	}
+	if q.Version != 2 {
+		t.Errorf("Version = %d, want 2", q.Version)
+	}
+}
+
+func TestDifficultyHistogram(t *testing.T) {
+	questions := func(counts map[Difficulty]int) []Question {
+		var qs []Question
+		for d, n := range counts {
+			for range n {
+				qs = append(qs, Question{Difficulty: d})
+			}
+		}
+		return qs
+	}
+
+	tests := []struct {
+		name   string
+		counts map[Difficulty]int
+		labels map[Difficulty]string
+		want   string
+	}{
+		{
+			name: "empty",
+			want: "Very Easy |  0\nEasy      |  0\nMedium    |  0\nHard      |  0\nVery Hard |  0\n",
+		},
+		{
+			name:   "aligned by the longest display label",
+			counts: map[Difficulty]int{Medium: 1},
+			labels: map[Difficulty]string{VeryEasy: "Muito Fácil", Easy: "Fácil", Medium: "Médio", Hard: "Difícil", VeryHard: "Muito Difícil"},
+			want: "Muito Fácil   |  0\n" +
+				"Fácil         |  0\n" +
+				"Médio         | " + strings.Repeat("█", 40) + " 1\n" +
+				"Difícil       |  0\n" +
+				"Muito Difícil |  0\n",
+		},
+		{
+			name:   "scaled to the largest level",
+			counts: map[Difficulty]int{Easy: 2, Medium: 8, VeryHard: 4},
+			want: "Very Easy |  0\n" +
+				"Easy      | " + strings.Repeat("█", 10) + " 2\n" +
+				"Medium    | " + strings.Repeat("█", 40) + " 8\n" +
+				"Hard      |  0\n" +
+				"Very Hard | " + strings.Repeat("█", 20) + " 4\n",
+		},
+		{
+			name:   "small counts keep one block",
+			counts: map[Difficulty]int{VeryEasy: 1, Hard: 100},
+			want: "Very Easy | █ 1\n" +
+				"Easy      |  0\n" +
+				"Medium    |  0\n" +
+				"Hard      | " + strings.Repeat("█", 40) + " 100\n" +
+				"Very Hard |  0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDifficultyLabels(tt.labels)
+			t.Cleanup(func() { SetDifficultyLabels(nil) })
+
+			if got := DifficultyHistogram(questions(tt.counts)); got != tt.want {
+				t.Errorf("DifficultyHistogram() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantityOptionsMessage(t *testing.T) {
+	options := func(n int) []Option {
+		opts := make([]Option, n)
+		for i := range opts {
+			opts[i] = Option{ID: testID(1001 + i), QuestionID: testID(1000), Content: fmt.Sprint(i), IsCorrect: i == 0}
+		}
+		return opts
+	}
+
+	tests := []struct {
+		name       string
+		difficulty Difficulty
+		options    []Option
+		want       string
+	}{
+		{name: "too many", difficulty: Medium, options: options(5), want: "got 5 options, difficulty Medium allows 2-4"},
+		{name: "too few", difficulty: VeryHard, options: options(1), want: "got 1 options, difficulty Very Hard allows 2-6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewQuestion(testID(1000), testID(2000), "What is 6 x 7?", tt.difficulty, tt.options)
+			if !errors.Is(err, ErrQuantityOptions) {
+				t.Fatalf("NewQuestion() error = %v, want %v", err, ErrQuantityOptions)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewQuestion() error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionVersion(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+	if q.Version != 1 {
+		t.Fatalf("NewQuestion() Version = %d, want 1", q.Version)
+	}
+
+	edits := []func() error{
+		func() error { return q.UpdateContent("What is 7 x 6?") },
+		func() error { return q.UpdateDifficulty(Hard) },
+		func() error { return q.AddOption(Option{ID: testID(1500), Content: "12"}) },
+	}
+	for i, edit := range edits {
+		if err := edit(); err != nil {
+			t.Fatalf("edit %d error = %v", i+1, err)
+		}
+	}
+	if q.Version != 4 {
+		t.Errorf("Version after three edits = %d, want 4", q.Version)
+	}
+
+	if err := q.UpdateContent(""); err == nil {
+		t.Fatalf("UpdateContent(\"\") error = nil, want error")
+	}
+	if q.Version != 4 {
+		t.Errorf("Version after a failed edit = %d, want 4", q.Version)
+	}
+
+	q.Version = 0
+	if err := q.Validate(); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("Validate() with Version 0 error = %v, want %v", err, ErrInvalidVersion)
+	}
+}
+
+func TestQuestionCheckVersion(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+	if err := q.UpdateContent("What is 7 x 6?"); err != nil {
+		t.Fatalf("UpdateContent() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		expected int
+		want     error
+	}{
+		{name: "current version", expected: 2},
+		{name: "stale copy", expected: 1, want: ErrVersionConflict},
+		{name: "future version", expected: 3, want: ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := q.CheckVersion(tt.expected)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CheckVersion(%d) error = %v, want %v", tt.expected, err, tt.want)
+			}
+			if err != nil && !strings.Contains(err.Error(), fmt.Sprintf("got 2, expected %d", tt.expected)) {
+				t.Errorf("CheckVersion(%d) error = %q, want the versions in the message", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestQuestionShuffledOptionsPinned(t *testing.T) {
+	q := newTestQuestion(t, VeryHard, "42", "24", "12", "6", "None of the above")
+	q.Options[4].Pinned = true
+	if err := q.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	orders := make(map[string]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		shuffled := q.ShuffledOptions(seed)
+		if len(shuffled) != len(q.Options) {
+			t.Fatalf("len(ShuffledOptions(%d)) = %d, want %d", seed, len(shuffled), len(q.Options))
+		}
+		if last := shuffled[len(shuffled)-1]; last.ID != testID(1005) {
+			t.Errorf("ShuffledOptions(%d) last = %q, want the pinned option", seed, last.Content)
+		}
+
+		var order []string
+		for _, opt := range shuffled {
+			order = append(order, opt.Content)
+		}
+		orders[strings.Join(order, ",")] = true
+	}
+	if len(orders) < 2 {
+		t.Errorf("ShuffledOptions() produced %d distinct orders across seeds, want more", len(orders))
+	}
+	if q.Options[0].Content != "42" {
+		t.Errorf("ShuffledOptions() modified q.Options")
+	}
+
+	q.Options[3].Pinned = true
+	if err := q.Validate(); !errors.Is(err, ErrMultiplePinnedOptions) {
+		t.Errorf("Validate() with two pinned options error = %v, want %v", err, ErrMultiplePinnedOptions)
+	}
+}
+
+func TestQuestionHasAmbiguousOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents []string
+		correct  []bool
+		want     bool
+		wantErr  error
+	}{
+		{name: "two 42 options, one correct", contents: []string{"42", "24", " 42 "}, correct: []bool{true, false, false}, want: true, wantErr: ErrAmbiguousOptions},
+		{name: "case-insensitive", contents: []string{"Yes", "yes"}, correct: []bool{true, false}, want: true, wantErr: ErrAmbiguousOptions},
+		{name: "distinct contents", contents: []string{"42", "24"}, correct: []bool{true, false}},
+		{name: "duplicates with the same correctness", contents: []string{"42", "24", "24"}, correct: []bool{true, false, false}, wantErr: ErrDuplicateOptionContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := Question{ID: testID(1000), SubjectID: testID(2000), Content: "What is 6 x 7?", Difficulty: Medium, Type: QuestionTypeSingleChoice, Version: 1}
+			for i, content := range tt.contents {
+				q.Options = append(q.Options, Option{ID: testID(1001 + i), QuestionID: q.ID, Content: content, IsCorrect: tt.correct[i]})
+			}
+
+			if got := q.HasAmbiguousOptions(); got != tt.want {
+				t.Errorf("HasAmbiguousOptions() = %v, want %v", got, tt.want)
+			}
+			if err := q.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionPublish(t *testing.T) {
+	q := newTestQuestion(t, Medium, "42", "24")
+	if q.IsPublished() {
+		t.Fatalf("NewQuestion() IsPublished() = true, want a draft")
+	}
+
+	invalid := *q
+	invalid.Content = ""
+	if err := invalid.Publish(); !errors.Is(err, ErrEmptyQuestionContent) {
+		t.Errorf("Publish() invalid question error = %v, want %v", err, ErrEmptyQuestionContent)
+	}
+	if invalid.IsPublished() || invalid.Version != 1 {
+		t.Errorf("invalid question Published = %v, Version = %d, want false, 1", invalid.Published, invalid.Version)
+	}
+
+	if err := q.Publish(); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if err := q.Publish(); err != nil {
+		t.Fatalf("Publish() again error = %v", err)
+	}
+	if !q.IsPublished() || q.Version != 2 {
+		t.Errorf("Published = %v, Version = %d, want true, 2", q.Published, q.Version)
+	}
+
+	q.Unpublish()
+	q.Unpublish()
+	if q.IsPublished() || q.Version != 3 {
+		t.Errorf("after Unpublish() Published = %v, Version = %d, want false, 3", q.Published, q.Version)
+	}
+}
+
+func TestQuestionPublishCtxDistractorFeedback(t *testing.T) {
+	ctx := ValidationContext{Strict: true, RequireDistractorFeedback: true}
+	q := newTestQuestion(t, Medium, "42", "24")
+
+	if err := q.PublishCtx(ctx); !errors.Is(err, ErrMissingDistractorFeedback) {
+		t.Errorf("PublishCtx() without feedback error = %v, want %v", err, ErrMissingDistractorFeedback)
+	}
+	if q.IsPublished() || q.Version != 1 {
+		t.Errorf("Published = %v, Version = %d, want false, 1", q.Published, q.Version)
+	}
+
+	err := validateOptionsWith(ctx, q.Options, q.Difficulty, q.Type, QuestionConstraints{})
+	if !errors.Is(err, ErrMissingDistractorFeedback) {
+		t.Errorf("validateOptionsWith() error = %v, want %v", err, ErrMissingDistractorFeedback)
+	}
+
+	q.Options[1].Feedback = "24 is 6 x 4"
+	if err := q.PublishCtx(ctx); err != nil {
+		t.Fatalf("PublishCtx() with feedback error = %v", err)
+	}
+	if !q.IsPublished() {
+		t.Errorf("PublishCtx() IsPublished() = false, want true")
+	}
+}
+
+func TestQuestionEstimatedReadingTime(t *testing.T) {
+	// 20 palavras no conteúdo e 10 nas opções.
+	q := &Question{
+		Content: "A train leaves the station at noon and travels at sixty kilometers per hour for exactly two hours. How far?",
+		Options: []Option{
+			{Content: "One hundred twenty kilometers"},
+			{Content: "Sixty kilometers"},
+			{Content: "  Two   hundred kilometers "},
+			{Content: "Zero"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		wordsPerMinute int
+		want           time.Duration
+	}{
+		{name: "slow reader", wordsPerMinute: 60, want: 30 * time.Second},
+		{name: "default speed", wordsPerMinute: 0, want: 9 * time.Second},
+		{name: "negative uses default", wordsPerMinute: -10, want: 9 * time.Second},
+		{name: "exactly the minimum", wordsPerMinute: 600, want: MinReadingTime},
+		{name: "below the minimum", wordsPerMinute: 6000, want: MinReadingTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := q.EstimatedReadingTime(tt.wordsPerMinute); got != tt.want {
+				t.Errorf("EstimatedReadingTime(%d) = %v, want %v", tt.wordsPerMinute, got, tt.want)
+			}
+		})
+	}
+}
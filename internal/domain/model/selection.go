@@ -0,0 +1,84 @@
+package model
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// Erros específicos da seleção de perguntas
+var (
+	ErrNotEnoughQuestions = newDomainError("NOT_ENOUGH_QUESTIONS", "question pool has fewer questions than requested")
+)
+
+// SelectBalanced seleciona n perguntas do banco distribuídas da forma mais
+// uniforme possível entre os níveis de dificuldade presentes. Quando um nível
+// não tem perguntas suficientes, a diferença é completada alternadamente com
+// os níveis vizinhos mais próximos, começando pelo mais fácil. Perguntas não
+// publicadas ou com dificuldade inválida são ignoradas.
+//
+// A seleção usa um gerador aleatório com a semente informada, de modo que a
+// mesma semente e o mesmo banco produzem o mesmo resultado.
+//
+// Em caso de erro retorna ErrNotEnoughQuestions.
+func SelectBalanced(pool []Question, n int, seed int64) ([]Question, error) {
+	groups := make(map[Difficulty][]Question)
+	for _, q := range pool {
+		if q.IsPublished() {
+			groups[q.Difficulty] = append(groups[q.Difficulty], q)
+		}
+	}
+
+	var levels []Difficulty
+	eligible := 0
+	for _, difficulty := range difficultyLevels {
+		if len(groups[difficulty]) > 0 {
+			levels = append(levels, difficulty)
+			eligible += len(groups[difficulty])
+		}
+	}
+
+	if n > eligible {
+		return nil, fmt.Errorf("%w: got %d questions, requested %d", ErrNotEnoughQuestions, eligible, n)
+	}
+	if n <= 0 {
+		return []Question{}, nil
+	}
+
+	quotas := make([]int, len(levels))
+	deficits := make([]int, len(levels))
+	for i, difficulty := range levels {
+		quota := n / len(levels)
+		if i < n%len(levels) {
+			quota++
+		}
+		quotas[i] = min(quota, len(groups[difficulty]))
+		deficits[i] = quota - quotas[i]
+	}
+
+	for i := range levels {
+		for distance := 1; deficits[i] > 0 && distance < len(levels); distance++ {
+			for taken := true; taken && deficits[i] > 0; {
+				taken = false
+				for _, j := range []int{i - distance, i + distance} {
+					if j >= 0 && j < len(levels) && deficits[i] > 0 && quotas[j] < len(groups[levels[j]]) {
+						quotas[j]++
+						deficits[i]--
+						taken = true
+					}
+				}
+			}
+		}
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+
+	selected := make([]Question, 0, n)
+	for i, difficulty := range levels {
+		group := groups[difficulty]
+		rng.Shuffle(len(group), func(a, b int) { group[a], group[b] = group[b], group[a] })
+		selected = append(selected, group[:quotas[i]]...)
+	}
+
+	rng.Shuffle(len(selected), func(a, b int) { selected[a], selected[b] = selected[b], selected[a] })
+	return selected, nil
+}
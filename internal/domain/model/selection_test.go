@@ -0,0 +1,89 @@
+package model
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// newTestPool cria um banco de perguntas publicadas com a quantidade
+// informada por nível de dificuldade.
+func newTestPool(counts map[Difficulty]int) []Question {
+	var pool []Question
+	n := 1
+	for _, d := range difficultyLevels {
+		for range counts[d] {
+			pool = append(pool, Question{ID: testID(n), Difficulty: d, Published: true})
+			n++
+		}
+	}
+	return pool
+}
+
+func TestSelectBalanced(t *testing.T) {
+	unpublished := newTestPool(map[Difficulty]int{Hard: 3})
+	for i := range unpublished {
+		unpublished[i].ID = testID(900 + i)
+		unpublished[i].Published = false
+	}
+
+	tests := []struct {
+		name    string
+		pool    []Question
+		n       int
+		want    map[Difficulty]int
+		wantErr error
+	}{
+		{name: "even split", pool: newTestPool(map[Difficulty]int{Easy: 4, Medium: 4, Hard: 4}), n: 6, want: map[Difficulty]int{Easy: 2, Medium: 2, Hard: 2}},
+		{name: "remainder goes to the easier levels", pool: newTestPool(map[Difficulty]int{Easy: 4, Medium: 4, Hard: 4}), n: 8, want: map[Difficulty]int{Easy: 3, Medium: 3, Hard: 2}},
+		{name: "shortfall filled from adjacent level", pool: newTestPool(map[Difficulty]int{VeryEasy: 1, Easy: 5, Medium: 5}), n: 9, want: map[Difficulty]int{VeryEasy: 1, Easy: 5, Medium: 3}},
+		{name: "unpublished questions are ignored", pool: append(newTestPool(map[Difficulty]int{Medium: 2}), unpublished...), n: 2, want: map[Difficulty]int{Medium: 2}},
+		{name: "not enough published questions", pool: append(newTestPool(map[Difficulty]int{Medium: 2}), unpublished...), n: 3, wantErr: ErrNotEnoughQuestions},
+		{name: "zero", pool: newTestPool(map[Difficulty]int{Medium: 2}), want: map[Difficulty]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := SelectBalanced(tt.pool, tt.n, 42)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SelectBalanced() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			seen := make(map[string]bool, len(selected))
+			for _, q := range selected {
+				if seen[q.ID] {
+					t.Errorf("SelectBalanced() selected %q twice", q.ID)
+				}
+				seen[q.ID] = true
+			}
+			if got := DifficultyDistribution(selected); !maps.Equal(got, tt.want) {
+				t.Errorf("DifficultyDistribution(SelectBalanced()) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectBalancedDeterministic(t *testing.T) {
+	pool := newTestPool(map[Difficulty]int{Easy: 10, Medium: 10, Hard: 10})
+
+	ids := func(seed int64) []string {
+		selected, err := SelectBalanced(pool, 9, seed)
+		if err != nil {
+			t.Fatalf("SelectBalanced() error = %v", err)
+		}
+		ids := make([]string, len(selected))
+		for i, q := range selected {
+			ids[i] = q.ID
+		}
+		return ids
+	}
+
+	first := ids(7)
+	if again := ids(7); !slices.Equal(first, again) {
+		t.Errorf("SelectBalanced() with the same seed = %v, want %v", again, first)
+	}
+}
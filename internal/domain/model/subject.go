@@ -2,36 +2,53 @@ package model
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 )
 
 // Erros específicos do modelo Subject
 var (
-	ErrInvalidSubjectName = errors.New("subject name cannot be less than 3 characters")
-	ErrSubjectIDEmpty     = errors.New("subject ID cannot be empty")
+	ErrInvalidSubjectName = newDomainError("INVALID_SUBJECT_NAME", "subject name cannot be less than 3 characters")
+	ErrSubjectIDEmpty     = newDomainError("SUBJECT_ID_EMPTY", "subject ID cannot be empty")
+	ErrSubjectCycle       = newDomainError("SUBJECT_CYCLE", "subject hierarchy cannot contain cycles")
+	ErrSubjectTooDeep     = newDomainError("SUBJECT_TOO_DEEP", "subject hierarchy exceeds the maximum depth")
+	ErrParentNotFound     = newDomainError("PARENT_NOT_FOUND", "parent subject not found")
+	ErrMergeSameSubject   = newDomainError("MERGE_SAME_SUBJECT", "cannot merge a subject into itself")
+	ErrSubjectNotFound    = newDomainError("SUBJECT_NOT_FOUND", "subject not found")
 )
 
-// Subject representa uma disciplina ou matéria
+// MinSubjectNameLength é a quantidade mínima de caracteres do nome da disciplina.
+const MinSubjectNameLength = 3
+
+// MaxSubjectDepth é a quantidade máxima de níveis na hierarquia de disciplinas.
+const MaxSubjectDepth = 10
+
+// Subject representa uma disciplina ou matéria.
+//
+// QuestionCount é um cache desnormalizado da quantidade de perguntas da
+// disciplina, mantido pelos repositórios ao criar e remover perguntas. Não é a
+// fonte autoritativa: a contagem real deve ser obtida das perguntas.
 type Subject struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	ParentID      string    `json:"parentId"`
+	QuestionCount int       `json:"questionCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
 }
 
 // NewSubject cria uma nova instância de Subject.
 //
 // Em caso de erro retorna ValidationError.
 func NewSubject(id, name string) (*Subject, error) {
-	now := time.Now()
+	timestamp := now()
 	subject := &Subject{
 		ID:        id,
 		Name:      name,
-		CreatedAt: now,
-		UpdatedAt: now,
+		CreatedAt: timestamp,
+		UpdatedAt: timestamp,
 	}
 
 	if err := subject.Validate(); err != nil {
@@ -44,18 +61,41 @@ func NewSubject(id, name string) (*Subject, error) {
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func (s *Subject) Validate() error {
+	return s.ValidateCtx(DefaultValidationContext)
+}
+
+// ValidateCtx verifica se os dados da disciplina são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (s *Subject) ValidateCtx(ctx ValidationContext) error {
 	ve := &ValidationError{}
-	
+
 	if strings.TrimSpace(s.ID) == "" {
 		ve.Add(ErrSubjectIDEmpty)
+	} else if err := ctx.validateID(s.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if err := validateSubjectName(s.Name); err != nil {
 		ve.Add(err)
 	}
 
+	if err := ctx.validateSafeText(s.Name); err != nil {
+		ve.Add(err)
+	}
+
+	if s.QuestionCount < 0 {
+		ve.Add(ErrInvalidCounter)
+	}
+
+	if s.ParentID != "" && s.ParentID == s.ID {
+		ve.Add(ErrSubjectCycle)
+	}
+
 	if ve.HasErrors() {
-		return ve
+		return ctx.localize(ve)
 	}
 
 	return nil
@@ -65,7 +105,7 @@ func (s *Subject) Validate() error {
 //
 // Em caso de erro retorna ErrInvalidSubjectName.
 func validateSubjectName(name string) error {
-	if len(strings.TrimSpace(name)) < 3 {
+	if len(strings.TrimSpace(name)) < MinSubjectNameLength {
 		return ErrInvalidSubjectName
 	}
 	return nil
@@ -79,10 +119,121 @@ func (s *Subject) UpdateName(newName string) error {
 		return err
 	}
 	s.Name = newName
-	s.UpdatedAt = time.Now()
+	s.UpdatedAt = now()
+	return nil
+}
+
+// IncrementQuestionCount incrementa o contador de perguntas em 1.
+func (s *Subject) IncrementQuestionCount() {
+	s.QuestionCount++
+	s.UpdatedAt = now()
+}
+
+// DecrementQuestionCount decrementa o contador de perguntas em 1, sem ficar
+// abaixo de zero.
+func (s *Subject) DecrementQuestionCount() {
+	if s.QuestionCount > 0 {
+		s.QuestionCount--
+	}
+	s.UpdatedAt = now()
+}
+
+// IsRoot verifica se a disciplina não possui disciplina pai
+func (s *Subject) IsRoot() bool {
+	return s.ParentID == ""
+}
+
+// UniqueKey retorna a chave usada para garantir nomes únicos entre disciplinas
+// irmãs: o ID da disciplina pai, uma barra e o nome normalizado (sem
+// diferenciar maiúsculas, minúsculas e acentos).
+//
+// Disciplinas de nível superior usam o prefixo vazio (ex.: "/algebra").
+func (s *Subject) UniqueKey() string {
+	return s.ParentID + "/" + strings.Join(strings.Fields(foldText(s.Name)), " ")
+}
+
+// MergeSubjects consolida a disciplina duplicada na principal, somando o
+// contador de perguntas. A disciplina principal é mantida e a duplicada tem o
+// contador zerado, devendo ser removida pelo chamador após reatribuir as
+// perguntas (ver ReassignQuestions) e as disciplinas filhas.
+//
+// Em caso de erro retorna ErrMergeSameSubject.
+func MergeSubjects(primary, duplicate *Subject) error {
+	if primary.ID == duplicate.ID {
+		return ErrMergeSameSubject
+	}
+
+	timestamp := now()
+	primary.QuestionCount += duplicate.QuestionCount
+	primary.UpdatedAt = timestamp
+	duplicate.QuestionCount = 0
+	duplicate.UpdatedAt = timestamp
 	return nil
 }
 
+// ReassignQuestions altera para toSubjectID a disciplina das perguntas que
+// pertencem a fromSubjectID, validando cada pergunta após a alteração.
+// Perguntas que se tornariam inválidas mantêm a disciplina original.
+//
+// Retorna a quantidade de perguntas alteradas. Em caso de erro retorna também
+// ValidationError com um erro por pergunta que não pôde ser alterada.
+func ReassignQuestions(questions []Question, fromSubjectID, toSubjectID string) (int, error) {
+	ve := &ValidationError{}
+	changed := 0
+
+	for i := range questions {
+		q := &questions[i]
+		if q.SubjectID != fromSubjectID || fromSubjectID == toSubjectID {
+			continue
+		}
+
+		q.SubjectID = toSubjectID
+		if err := q.Validate(); err != nil {
+			q.SubjectID = fromSubjectID
+			ve.Add(fmt.Errorf("question %q: %w", q.ID, err))
+			continue
+		}
+
+		q.touch(now())
+		changed++
+	}
+
+	if ve.HasErrors() {
+		return changed, ve
+	}
+	return changed, nil
+}
+
+// BuildSubjectPath retorna o caminho da disciplina a partir da raiz (ex.:
+// Matemática > Álgebra > Equações), buscando as disciplinas pai com lookup.
+//
+// Em caso de erro retorna ErrParentNotFound, ErrSubjectCycle ou ErrSubjectTooDeep.
+func BuildSubjectPath(s *Subject, lookup func(id string) (*Subject, bool)) ([]*Subject, error) {
+	path := []*Subject{s}
+	visited := map[string]bool{s.ID: true}
+
+	for current := s; !current.IsRoot(); {
+		if len(path) >= MaxSubjectDepth {
+			return nil, ErrSubjectTooDeep
+		}
+		if visited[current.ParentID] {
+			return nil, ErrSubjectCycle
+		}
+
+		parent, ok := lookup(current.ParentID)
+		if !ok {
+			return nil, ErrParentNotFound
+		}
+
+		visited[parent.ID] = true
+		path = append(path, parent)
+		current = parent
+	}
+
+	slices.Reverse(path)
+	return path, nil
+}
+
 // String retorna uma representação em JSON da disciplina
 func (s *Subject) String() string {
 	data, err := json.MarshalIndent(s, "", "  ")
@@ -0,0 +1,224 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"educational-reinforcement-platform/pkg/clock"
+)
+
+// useFakeClock instala um relógio parado no instante informado e restaura o
+// relógio do sistema ao final do teste.
+func useFakeClock(t *testing.T, at time.Time) *clock.FakeClock {
+	t.Helper()
+	fake := clock.NewFakeClock(at)
+	SetClock(fake)
+	t.Cleanup(func() { SetClock(nil) })
+	return fake
+}
+
+// newTestSubject cria uma disciplina válida com o ID e o nome informados.
+func newTestSubject(t *testing.T, n int, name string) *Subject {
+	t.Helper()
+
+	subject, err := NewSubject(testID(n), name)
+	if err != nil {
+		t.Fatalf("NewSubject() error = %v", err)
+	}
+	return subject
+}
+
+func TestSubjectQuestionCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      int
+		increments int
+		decrements int
+		want       int
+	}{
+		{name: "increment", increments: 3, want: 3},
+		{name: "increment and decrement", start: 2, increments: 1, decrements: 2, want: 1},
+		{name: "floor at zero", start: 1, decrements: 3, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+			fake := useFakeClock(t, start)
+			subject := newTestSubject(t, 1, "Algebra")
+			subject.QuestionCount = tt.start
+
+			fake.Advance(time.Minute)
+			for range tt.increments {
+				subject.IncrementQuestionCount()
+			}
+			for range tt.decrements {
+				subject.DecrementQuestionCount()
+			}
+
+			if subject.QuestionCount != tt.want {
+				t.Errorf("QuestionCount = %d, want %d", subject.QuestionCount, tt.want)
+			}
+			if want := start.Add(time.Minute); !subject.UpdatedAt.Equal(want) {
+				t.Errorf("UpdatedAt = %v, want %v", subject.UpdatedAt, want)
+			}
+		})
+	}
+}
+
+func TestSubjectValidateQuestionCount(t *testing.T) {
+	subject := newTestSubject(t, 1, "Algebra")
+	subject.QuestionCount = -1
+
+	if err := subject.Validate(); !errors.Is(err, ErrInvalidCounter) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrInvalidCounter)
+	}
+}
+
+func TestBuildSubjectPath(t *testing.T) {
+	subjects := map[string]*Subject{}
+	add := func(n int, name string, parent int) *Subject {
+		s := &Subject{ID: testID(n), Name: name}
+		if parent > 0 {
+			s.ParentID = testID(parent)
+		}
+		subjects[s.ID] = s
+		return s
+	}
+	lookup := func(id string) (*Subject, bool) {
+		s, ok := subjects[id]
+		return s, ok
+	}
+
+	add(1, "Mathematics", 0)
+	add(2, "Algebra", 1)
+	equations := add(3, "Equations", 2)
+	cycleA := add(10, "Cycle A", 11)
+	add(11, "Cycle B", 10)
+	orphan := add(20, "Orphan", 99)
+	deep := add(100, "Level 0", 0)
+	for i := 1; i <= MaxSubjectDepth; i++ {
+		deep = add(100+i, "Level", 100+i-1)
+	}
+
+	tests := []struct {
+		name    string
+		subject *Subject
+		want    []string
+		wantErr error
+	}{
+		{name: "root first", subject: equations, want: []string{"Mathematics", "Algebra", "Equations"}},
+		{name: "root", subject: subjects[testID(1)], want: []string{"Mathematics"}},
+		{name: "cycle", subject: cycleA, wantErr: ErrSubjectCycle},
+		{name: "self parent", subject: &Subject{ID: testID(30), Name: "Self", ParentID: testID(30)}, wantErr: ErrSubjectCycle},
+		{name: "missing parent", subject: orphan, wantErr: ErrParentNotFound},
+		{name: "too deep", subject: deep, wantErr: ErrSubjectTooDeep},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := BuildSubjectPath(tt.subject, lookup)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BuildSubjectPath() error = %v, want %v", err, tt.wantErr)
+			}
+
+			names := make([]string, 0, len(path))
+			for _, s := range path {
+				names = append(names, s.Name)
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("BuildSubjectPath() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubjectUniqueKey(t *testing.T) {
+	parentA, parentB := testID(1), testID(2)
+
+	tests := []struct {
+		name     string
+		a, b     Subject
+		wantSame bool
+	}{
+		{name: "accents and case under the same parent", a: Subject{Name: "Álgebra", ParentID: parentA}, b: Subject{Name: "algebra", ParentID: parentA}, wantSame: true},
+		{name: "extra spaces", a: Subject{Name: "  Álgebra   Linear "}, b: Subject{Name: "algebra linear"}, wantSame: true},
+		{name: "different parents", a: Subject{Name: "Álgebra", ParentID: parentA}, b: Subject{Name: "algebra", ParentID: parentB}},
+		{name: "top level and child", a: Subject{Name: "Álgebra"}, b: Subject{Name: "algebra", ParentID: parentA}},
+		{name: "different names", a: Subject{Name: "Álgebra", ParentID: parentA}, b: Subject{Name: "Geometria", ParentID: parentA}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.UniqueKey() == tt.b.UniqueKey(); got != tt.wantSame {
+				t.Errorf("UniqueKey() %q == %q is %v, want %v", tt.a.UniqueKey(), tt.b.UniqueKey(), got, tt.wantSame)
+			}
+		})
+	}
+
+	if got := (&Subject{Name: "Álgebra"}).UniqueKey(); got != "/algebra" {
+		t.Errorf("UniqueKey() = %q, want %q", got, "/algebra")
+	}
+}
+
+func TestMergeSubjects(t *testing.T) {
+	primary := newTestSubject(t, 1, "Algebra")
+	primary.QuestionCount = 3
+	duplicate := newTestSubject(t, 2, "Álgebra")
+	duplicate.QuestionCount = 2
+
+	if err := MergeSubjects(primary, duplicate); err != nil {
+		t.Fatalf("MergeSubjects() error = %v", err)
+	}
+	if primary.QuestionCount != 5 || duplicate.QuestionCount != 0 {
+		t.Errorf("QuestionCount = %d, %d, want 5, 0", primary.QuestionCount, duplicate.QuestionCount)
+	}
+
+	if err := MergeSubjects(primary, primary); !errors.Is(err, ErrMergeSameSubject) {
+		t.Errorf("MergeSubjects(same) error = %v, want %v", err, ErrMergeSameSubject)
+	}
+}
+
+func TestReassignQuestions(t *testing.T) {
+	from, to := testID(2000), testID(2001)
+	question := func(n int, subjectID string) Question {
+		q := *newTestQuestion(t, Medium, "42", "24")
+		q.ID = testID(n)
+		q.SubjectID = subjectID
+		for i := range q.Options {
+			q.Options[i].QuestionID = q.ID
+		}
+		return q
+	}
+
+	invalid := question(3, from)
+	invalid.Content = ""
+
+	questions := []Question{question(1, from), question(2, to), invalid, question(4, from)}
+
+	changed, err := ReassignQuestions(questions, from, to)
+	if changed != 2 {
+		t.Errorf("ReassignQuestions() changed = %d, want 2", changed)
+	}
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Count() != 1 || !errors.Is(err, ErrEmptyQuestionContent) {
+		t.Fatalf("ReassignQuestions() error = %v, want one ErrEmptyQuestionContent", err)
+	}
+
+	want := []string{to, to, from, to}
+	for i, q := range questions {
+		if q.SubjectID != want[i] {
+			t.Errorf("questions[%d].SubjectID = %q, want %q", i, q.SubjectID, want[i])
+		}
+	}
+	if questions[0].Version != 2 || questions[2].Version != 1 {
+		t.Errorf("Version = %d, %d, want 2, 1", questions[0].Version, questions[2].Version)
+	}
+
+	if changed, err := ReassignQuestions(questions, to, to); changed != 0 || err != nil {
+		t.Errorf("ReassignQuestions(same subject) = %d, %v, want 0, nil", changed, err)
+	}
+}
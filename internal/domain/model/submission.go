@@ -0,0 +1,64 @@
+package model
+
+import (
+	"fmt"
+
+	"educational-reinforcement-platform/pkg"
+)
+
+// SubmissionItem representa uma resposta enviada pelo usuário em um quiz.
+type SubmissionItem struct {
+	QuestionID  string `json:"questionId"`
+	OptionID    string `json:"optionId"`
+	TimeTakenMs int64  `json:"timeTakenMs"`
+}
+
+// BuildAnswers cria e corrige as respostas de um envio a partir das perguntas
+// informadas, gerando os IDs com pkg.NewID.
+//
+// O envio é aceito por completo ou rejeitado: se algum item for inválido,
+// nenhuma resposta é retornada.
+//
+// Em caso de erro retorna ValidationError com os erros de cada item, indexados
+// pela posição no envio (ErrQuestionNotFound, ErrOptionNotFound etc.).
+func BuildAnswers(userID string, items []SubmissionItem, questions map[string]*Question) ([]*Answer, error) {
+	ve := &ValidationError{}
+	answers := make([]*Answer, 0, len(items))
+
+	for i, item := range items {
+		question, ok := questions[item.QuestionID]
+		if !ok {
+			ve.Add(fmt.Errorf("item %d: %w", i, ErrQuestionNotFound))
+			continue
+		}
+
+		option, found := question.FindOption(item.OptionID)
+		if !found {
+			ve.Add(fmt.Errorf("item %d: %w", i, ErrOptionNotFound))
+			continue
+		}
+
+		id, err := pkg.NewID()
+		if err != nil {
+			return nil, fmt.Errorf("[model.BuildAnswers] ERROR: %w", err)
+		}
+
+		answer, err := NewAnswer(id, userID, question.ID, option.ID, option.IsCorrect)
+		if err != nil {
+			ve.Add(fmt.Errorf("item %d: %w", i, err))
+			continue
+		}
+
+		answer.TimeTakenMs = item.TimeTakenMs
+		if err := answer.Validate(); err != nil {
+			ve.Add(fmt.Errorf("item %d: %w", i, err))
+			continue
+		}
+		answers = append(answers, answer)
+	}
+
+	if ve.HasErrors() {
+		return nil, ve
+	}
+	return answers, nil
+}
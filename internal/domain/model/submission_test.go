@@ -0,0 +1,81 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBuildAnswers(t *testing.T) {
+	useSequentialIDs(t)
+	q := newTestQuestion(t, Medium, "42", "24", "12")
+	questions := map[string]*Question{q.ID: q}
+	userID := testID(1)
+
+	valid := []SubmissionItem{
+		{QuestionID: q.ID, OptionID: testID(1001), TimeTakenMs: 1500},
+		{QuestionID: q.ID, OptionID: testID(1003), TimeTakenMs: 900},
+	}
+
+	answers, err := BuildAnswers(userID, valid, questions)
+	if err != nil {
+		t.Fatalf("BuildAnswers() error = %v", err)
+	}
+	if len(answers) != 2 {
+		t.Fatalf("len(BuildAnswers()) = %d, want 2", len(answers))
+	}
+	if !answers[0].IsCorrect || answers[1].IsCorrect {
+		t.Errorf("IsCorrect = %v, %v, want true, false", answers[0].IsCorrect, answers[1].IsCorrect)
+	}
+	if answers[0].ID == answers[1].ID || answers[0].UserID != userID || answers[1].TimeTakenMs != 900 {
+		t.Errorf("BuildAnswers() = %+v, %+v", *answers[0], *answers[1])
+	}
+
+	tests := []struct {
+		name     string
+		items    []SubmissionItem
+		wantErrs []string
+		wantIs   []error
+	}{
+		{
+			name: "unknown question and foreign option",
+			items: []SubmissionItem{
+				valid[0],
+				{QuestionID: testID(2000), OptionID: testID(1001)},
+				{QuestionID: q.ID, OptionID: testID(3001)},
+			},
+			wantErrs: []string{"item 1", "item 2"},
+			wantIs:   []error{ErrQuestionNotFound, ErrOptionNotFound},
+		},
+		{
+			name:     "negative time",
+			items:    []SubmissionItem{valid[0], {QuestionID: q.ID, OptionID: testID(1002), TimeTakenMs: -1}},
+			wantErrs: []string{"item 1"},
+			wantIs:   []error{ErrInvalidTimeTaken},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answers, err := BuildAnswers(userID, tt.items, questions)
+			if answers != nil {
+				t.Errorf("BuildAnswers() = %v, want nil", answers)
+			}
+
+			var ve *ValidationError
+			if !errors.As(err, &ve) || ve.Count() != len(tt.wantErrs) {
+				t.Fatalf("BuildAnswers() error = %v, want %d errors", err, len(tt.wantErrs))
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("BuildAnswers() error = %q, want it to mention %q", err, want)
+				}
+			}
+			for _, want := range tt.wantIs {
+				if !errors.Is(err, want) {
+					t.Errorf("BuildAnswers() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Erros específicos de textos
+var (
+	ErrUnsafeContent  = newDomainError("UNSAFE_CONTENT", "text contains unsafe HTML such as scripts or event handlers")
+	ErrHTMLNotAllowed = newDomainError("HTML_NOT_ALLOWED", "text cannot contain HTML tags")
+)
+
+// unsafeHTMLRegex identifica construções HTML capazes de executar código:
+// tags como script e iframe, atributos de evento (onclick etc.) e URLs
+// javascript:.
+var unsafeHTMLRegex = regexp.MustCompile(
+	`(?i)<\s*/?\s*(script|iframe|object|embed|style|link|meta|base|form|svg)\b|<[a-zA-Z][^>]*\son[a-z]+\s*=|javascript\s*:`,
+)
+
+// htmlTagRegex identifica tags HTML de abertura, fechamento e comentários.
+// Os atributos precisam ter valor (ex.: href="x"), para que desigualdades como
+// "a<b and c>d" não sejam tratadas como tags.
+var htmlTagRegex = regexp.MustCompile(
+	`<!--[\s\S]*?-->|</?[a-zA-Z][a-zA-Z0-9-]*(\s+[a-zA-Z_:][a-zA-Z0-9_:.-]*\s*=\s*("[^"]*"|'[^']*'|[^\s"'<>=]+))*\s*/?>`,
+)
+
+// accentReplacer substitui caracteres acentuados latinos pela letra base.
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n", "ý", "y", "ÿ", "y",
+)
+
+// foldText normaliza o texto para comparações sem diferenciar maiúsculas,
+// minúsculas e acentos (ex.: "Álgebra" -> "algebra").
+func foldText(s string) string {
+	return accentReplacer.Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// containsUnsafeHTML verifica se o texto contém HTML capaz de executar código
+// ao ser exibido sem escape.
+func containsUnsafeHTML(s string) bool {
+	return unsafeHTMLRegex.MatchString(s)
+}
+
+// containsHTML verifica se o texto contém tags HTML ou comentários.
+func containsHTML(s string) bool {
+	return htmlTagRegex.MatchString(s)
+}
+
+// SanitizeText remove as tags HTML do texto, mantendo apenas o conteúdo
+// textual (ex.: "<b>Olá</b>" -> "Olá").
+//
+// É uma defesa adicional contra XSS armazenado e não substitui o escape do
+// conteúdo na exibição.
+func SanitizeText(s string) string {
+	return strings.TrimSpace(htmlTagRegex.ReplaceAllString(s, ""))
+}
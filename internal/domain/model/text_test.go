@@ -0,0 +1,109 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestContainsUnsafeHTML(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: "What is 6 x 7?"},
+		{input: "Is x < 3 and once = 2?"},
+		{input: "<b>bold</b> and <i>italic</i>"},
+		{input: "<script>alert(1)</script>", want: true},
+		{input: "< SCRIPT src=x>", want: true},
+		{input: `<img src=x onerror="alert(1)">`, want: true},
+		{input: "<iframe src=x></iframe>", want: true},
+		{input: "click javascript:alert(1)", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := containsUnsafeHTML(tt.input); got != tt.want {
+				t.Errorf("containsUnsafeHTML(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "<b>Olá</b>", want: "Olá"},
+		{input: "  <p>6 x 7</p> <!-- note -->", want: "6 x 7"},
+		{input: "<script>alert(1)</script>", want: "alert(1)"},
+		{input: "x < 3", want: "x < 3"},
+		{input: "a<b and c>d", want: "a<b and c>d"},
+		{input: "<a href=\"https://example.com\" target='_blank'>link</a><br/>", want: "link"},
+		{input: "<img src=x onerror=alert(1) />", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := SanitizeText(tt.input); got != tt.want {
+				t.Errorf("SanitizeText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationContextHTMLPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       HTMLPolicy
+		input        string
+		wantClean    string
+		wantCleanErr error
+		wantRawErr   error
+	}{
+		{name: "allow keeps tags", policy: HTMLAllow, input: "<script>xyz</script>", wantClean: "<script>xyz</script>"},
+		{name: "reject accepts bold", policy: HTMLReject, input: "<b>Olá</b>", wantClean: "<b>Olá</b>"},
+		{name: "reject rejects script", policy: HTMLReject, input: "<script>xyz</script>", wantClean: "<script>xyz</script>", wantCleanErr: ErrUnsafeContent, wantRawErr: ErrUnsafeContent},
+		{name: "strip removes bold", policy: HTMLStrip, input: "<b>Olá</b>", wantClean: "Olá", wantRawErr: ErrHTMLNotAllowed},
+		{name: "strip removes script", policy: HTMLStrip, input: "<script>xyz</script>", wantClean: "xyz", wantRawErr: ErrHTMLNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ValidationContext{Strict: true, HTML: tt.policy}
+
+			clean, err := ctx.CleanText(tt.input)
+			if clean != tt.wantClean || !errors.Is(err, tt.wantCleanErr) {
+				t.Errorf("CleanText() = %q, %v, want %q, %v", clean, err, tt.wantClean, tt.wantCleanErr)
+			}
+
+			subject := &Subject{ID: testID(1), Name: tt.input}
+			if err := subject.ValidateCtx(ctx); !errors.Is(err, tt.wantRawErr) {
+				t.Errorf("ValidateCtx() with raw text error = %v, want %v", err, tt.wantRawErr)
+			}
+
+			if tt.wantCleanErr == nil {
+				subject.Name = clean
+				if err := subject.ValidateCtx(ctx); err != nil {
+					t.Errorf("ValidateCtx() with cleaned text error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestQuestionValidateCtxHTMLFields(t *testing.T) {
+	ctx := ValidationContext{Strict: true, HTML: HTMLStrip}
+
+	q := newTestQuestion(t, Easy, "42", "41", "43")
+	q.Options[1].Feedback = "<i>Off by one</i>"
+
+	if err := q.ValidateCtx(ctx); !errors.Is(err, ErrHTMLNotAllowed) {
+		t.Fatalf("ValidateCtx() error = %v, want %v", err, ErrHTMLNotAllowed)
+	}
+
+	q.Options[1].Feedback, _ = ctx.CleanText(q.Options[1].Feedback)
+	if err := q.ValidateCtx(ctx); err != nil {
+		t.Errorf("ValidateCtx() after CleanText error = %v", err)
+	}
+}
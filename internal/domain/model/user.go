@@ -2,7 +2,6 @@ package model
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -11,15 +10,20 @@ import (
 
 // Erros específicos do modelo User
 var (
-	ErrInvalidName   = errors.New("user name cannot be less than 3 characters")
-	ErrInvalidRole   = errors.New("invalid role")
-	ErrEmptyRole     = errors.New("role cannot be empty")
-	ErrInvalidEmail  = errors.New("invalid email format")
-	ErrEmptyPassword = errors.New("password hash cannot be empty")
-	ErrEmptyEmail    = errors.New("email cannot be empty")
-	ErrUserIDEmpty   = errors.New("user ID cannot be empty")
+	ErrInvalidName    = newDomainError("INVALID_NAME", "user name cannot be less than 3 characters")
+	ErrInvalidRole    = newDomainError("INVALID_ROLE", "invalid role")
+	ErrEmptyRole      = newDomainError("EMPTY_ROLE", "role cannot be empty")
+	ErrInvalidEmail   = newDomainError("INVALID_EMAIL", "invalid email format")
+	ErrEmptyPassword  = newDomainError("EMPTY_PASSWORD", "password hash cannot be empty")
+	ErrEmptyEmail     = newDomainError("EMPTY_EMAIL", "email cannot be empty")
+	ErrUserIDEmpty    = newDomainError("USER_ID_EMPTY", "user ID cannot be empty")
+	ErrInvalidStatus  = newDomainError("INVALID_STATUS", "invalid status")
+	ErrUserNotPending = newDomainError("USER_NOT_PENDING", "user is not pending email verification")
 )
 
+// MinUserNameLength é a quantidade mínima de caracteres do nome do usuário.
+const MinUserNameLength = 3
+
 // Pattern para validação de email
 const emailRegexPattern = `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
 
@@ -40,6 +44,14 @@ type Status string
 const (
 	StatusActive   Status = "ACTIVE"
 	StatusInactive Status = "INACTIVE"
+	StatusPending  Status = "PENDING"
+	StatusDeleted  Status = "DELETED"
+)
+
+// Dados usados para substituir as informações pessoais de usuários anonimizados.
+const (
+	anonymizedName        = "Deleted User"
+	anonymizedEmailFormat = "deleted+%s@example.invalid"
 )
 
 // User representa um usuário do sistema.
@@ -51,6 +63,7 @@ type User struct {
 	Role         Role       `json:"role"`
 	Difficulty   Difficulty `json:"difficulty"`
 	Status       Status     `json:"status"`
+	LastLoginAt  *time.Time `json:"lastLoginAt"`
 	CreatedAt    time.Time  `json:"createdAt"`
 	UpdatedAt    time.Time  `json:"updatedAt"`
 }
@@ -59,7 +72,7 @@ type User struct {
 //
 // Em caso de erro retorna ValidationError.
 func NewUser(id, name, email, passwordHash string, role Role, difficulty Difficulty) (*User, error) {
-	now := time.Now()
+	timestamp := now()
 	user := &User{
 		ID:           id,
 		Name:         name,
@@ -68,8 +81,8 @@ func NewUser(id, name, email, passwordHash string, role Role, difficulty Difficu
 		Role:         role,
 		Difficulty:   difficulty,
 		Status:       StatusActive,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		CreatedAt:    timestamp,
+		UpdatedAt:    timestamp,
 	}
 
 	if err := user.Validate(); err != nil {
@@ -78,20 +91,48 @@ func NewUser(id, name, email, passwordHash string, role Role, difficulty Difficu
 	return user, nil
 }
 
+// NewPendingUser cria uma nova instância de User aguardando a verificação do
+// email. O usuário só se torna ativo após VerifyEmail.
+//
+// Em caso de erro retorna ValidationError.
+func NewPendingUser(id, name, email, passwordHash string, role Role, difficulty Difficulty) (*User, error) {
+	user, err := NewUser(id, name, email, passwordHash, role, difficulty)
+	if err != nil {
+		return nil, err
+	}
+	user.Status = StatusPending
+	return user, nil
+}
+
 // Validate verifica se os dados do usuário são válidos.
 //
 // Em caso de erro retorna ValidationError que contém todos os erros encontrados.
 func (u *User) Validate() error {
+	return u.ValidateCtx(DefaultValidationContext)
+}
+
+// ValidateCtx verifica se os dados do usuário são válidos de acordo com o
+// contexto de validação informado.
+//
+// Em caso de erro retorna ValidationError que contém todos os erros encontrados,
+// encapsulado em LocalizedError quando o contexto define outro idioma.
+func (u *User) ValidateCtx(ctx ValidationContext) error {
 	ve := &ValidationError{}
 
 	if strings.TrimSpace(u.ID) == "" {
 		ve.Add(ErrUserIDEmpty)
+	} else if err := ctx.validateID(u.ID); err != nil {
+		ve.Add(err)
 	}
 
 	if err := validateUserName(u.Name); err != nil {
 		ve.Add(err)
 	}
 
+	if err := ctx.validateSafeText(u.Name); err != nil {
+		ve.Add(err)
+	}
+
 	if err := validateEmail(u.Email); err != nil {
 		ve.Add(err)
 	}
@@ -104,11 +145,15 @@ func (u *User) Validate() error {
 		ve.Add(err)
 	}
 
-	if strings.TrimSpace(u.PasswordHash) == "" {
+	if err := validateStatus(u.Status); err != nil {
+		ve.Add(err)
+	}
+
+	if strings.TrimSpace(u.PasswordHash) == "" && u.Status != StatusDeleted {
 		ve.Add(ErrEmptyPassword)
 	}
 	if ve.HasErrors() {
-		return ve
+		return ctx.localize(ve)
 	}
 
 	return nil
@@ -118,7 +163,7 @@ func (u *User) Validate() error {
 //
 // Em caso de erro retorna ErrInvalidName.
 func validateUserName(name string) error {
-	if len(strings.TrimSpace(name)) < 3 {
+	if len(strings.TrimSpace(name)) < MinUserNameLength {
 		return ErrInvalidName
 	}
 	return nil
@@ -154,6 +199,18 @@ func validateRole(role Role) error {
 	}
 }
 
+// validateStatus verifica se o status é válido.
+//
+// Em caso de erro retorna ErrInvalidStatus.
+func validateStatus(status Status) error {
+	switch status {
+	case StatusActive, StatusInactive, StatusPending, StatusDeleted:
+		return nil
+	default:
+		return ErrInvalidStatus
+	}
+}
+
 // UpdateName atualiza o nome do usuário.
 //
 // Em caso de erro retorna ErrInvalidName.
@@ -162,7 +219,7 @@ func (u *User) UpdateName(newName string) error {
 		return err
 	}
 	u.Name = strings.TrimSpace(newName)
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
 	return nil
 }
 
@@ -174,7 +231,7 @@ func (u *User) UpdateEmail(newEmail string) error {
 		return err
 	}
 	u.Email = strings.ToLower(strings.TrimSpace(newEmail))
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
 	return nil
 }
 
@@ -186,22 +243,34 @@ func (u *User) UpdateRole(role Role) error {
 		return err
 	}
 	u.Role = role
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
 	return nil
 }
 
 // UpdateDifficulty atualiza a dificuldade do usuário.
 //
-// Em caso de erro retorna: ErrInvalidDifficulty ou ErrEmptyDifficulty.
+// Em caso de erro retorna: ErrInvalidDifficulty ou ErrDifficultyUnset.
 func (u *User) UpdateDifficulty(difficulty Difficulty) error {
 	if err := validateDifficulty(difficulty); err != nil {
 		return err
 	}
 	u.Difficulty = difficulty
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
 	return nil
 }
 
+// ApplyRecommendedDifficulty atualiza a dificuldade do usuário com a
+// recomendação de RecommendDifficulty para o desempenho recente.
+//
+// Em caso de erro retorna ErrInvalidDifficulty.
+func (u *User) ApplyRecommendedDifficulty(recentPerf *Performance) error {
+	recommended := RecommendDifficulty(u.Difficulty, recentPerf)
+	if recommended == u.Difficulty {
+		return nil
+	}
+	return u.UpdateDifficulty(recommended)
+}
+
 // IsAdmin verifica se o usuário é um administrador
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
@@ -215,13 +284,51 @@ func (u *User) IsUser() bool {
 // Activate ativa o usuário
 func (u *User) Activate() {
 	u.Status = StatusActive
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
 }
 
 // Deactivate desativa o usuário
 func (u *User) Deactivate() {
 	u.Status = StatusInactive
-	u.UpdatedAt = time.Now()
+	u.UpdatedAt = now()
+}
+
+// RecordLogin registra o horário do último login do usuário.
+func (u *User) RecordLogin() {
+	timestamp := now()
+	u.LastLoginAt = &timestamp
+	u.UpdatedAt = timestamp
+}
+
+// DeactivateStale desativa os usuários ativos cujo último login é anterior a
+// cutoff ou que nunca fizeram login. Administradores são ignorados, a menos
+// que includeAdmins seja verdadeiro.
+//
+// Retorna a quantidade de usuários desativados.
+func DeactivateStale(users []*User, cutoff time.Time, includeAdmins bool) int {
+	deactivated := 0
+	for _, u := range users {
+		if !u.IsActive() || (u.IsAdmin() && !includeAdmins) {
+			continue
+		}
+
+		if u.LastLoginAt == nil || u.LastLoginAt.Before(cutoff) {
+			u.Deactivate()
+			deactivated++
+		}
+	}
+	return deactivated
+}
+
+// VerifyEmail confirma o email do usuário pendente, tornando-o ativo.
+//
+// Em caso de erro retorna ErrUserNotPending.
+func (u *User) VerifyEmail() error {
+	if u.Status != StatusPending {
+		return ErrUserNotPending
+	}
+	u.Activate()
+	return nil
 }
 
 // IsActive verifica se o usuário está ativo
@@ -234,6 +341,29 @@ func (u *User) IsInactive() bool {
 	return u.Status == StatusInactive
 }
 
+// Anonymize remove as informações pessoais do usuário para atender a pedidos
+// de eliminação de dados (LGPD/GDPR).
+//
+// O ID é mantido para que respostas e desempenhos continuem referenciando um
+// usuário válido. O email é substituído por um endereço único e não roteável.
+func (u *User) Anonymize() {
+	u.Name = anonymizedName
+	u.Email = fmt.Sprintf(anonymizedEmailFormat, u.ID)
+	u.PasswordHash = ""
+	u.Status = StatusDeleted
+	u.UpdatedAt = now()
+}
+
+// IsDeleted verifica se o usuário foi anonimizado
+func (u *User) IsDeleted() bool {
+	return u.Status == StatusDeleted
+}
+
+// IsPending verifica se o usuário aguarda a verificação do email
+func (u *User) IsPending() bool {
+	return u.Status == StatusPending
+}
+
 // String retorna uma representação em JSON do usuário.
 //
 // Em caso de erro, retorna uma string de erro.
@@ -244,3 +374,72 @@ func (u *User) String() string {
 	}
 	return string(data)
 }
+
+// PublicView retorna uma cópia do usuário sem o hash da senha, segura para
+// respostas da API independentemente do formato de serialização.
+//
+// O usuário original não é alterado.
+func (u *User) PublicView() User {
+	public := *u
+	public.PasswordHash = ""
+	return public
+}
+
+// Redacted retorna uma cópia do usuário segura para logs, com o email
+// parcialmente mascarado e o nome reduzido às iniciais.
+//
+// O usuário original não é alterado.
+func (u *User) Redacted() User {
+	redacted := *u
+	redacted.Name = nameInitials(u.Name)
+	redacted.Email = maskEmail(u.Email)
+	redacted.PasswordHash = ""
+	return redacted
+}
+
+// nameInitials reduz o nome às iniciais de cada palavra (ex.: "João Silva" -> "J.S.").
+func nameInitials(name string) string {
+	var sb strings.Builder
+	for _, word := range strings.Fields(name) {
+		r := []rune(word)
+		sb.WriteString(strings.ToUpper(string(r[0])))
+		sb.WriteString(".")
+	}
+	return sb.String()
+}
+
+// maskEmail mascara a parte local do email mantendo apenas o primeiro
+// caractere (ex.: "joao@example.com" -> "j***@example.com").
+func maskEmail(email string) string {
+	local, domain, found := strings.Cut(strings.TrimSpace(email), "@")
+	if !found || local == "" {
+		return "***"
+	}
+	return string([]rune(local)[0]) + "***@" + domain
+}
+
+// UserFilter define critérios de busca de usuários. Apenas os critérios
+// definidos (não nulos ou não vazios) são aplicados.
+type UserFilter struct {
+	Role         *Role
+	Status       *Status
+	NameContains string
+}
+
+// Matches verifica se o usuário atende a todos os critérios definidos no
+// filtro. A busca por nome ignora maiúsculas, minúsculas e acentos.
+func (f UserFilter) Matches(u *User) bool {
+	if f.Role != nil && u.Role != *f.Role {
+		return false
+	}
+
+	if f.Status != nil && u.Status != *f.Status {
+		return false
+	}
+
+	if f.NameContains != "" && !strings.Contains(foldText(u.Name), foldText(f.NameContains)) {
+		return false
+	}
+
+	return true
+}
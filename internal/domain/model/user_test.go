@@ -0,0 +1,235 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestUser cria um usuário comum válido.
+func newTestUser(t *testing.T, n int, name, email string) *User {
+	t.Helper()
+
+	user, err := NewUser(testID(n), name, email, "hash", RoleUser, Medium)
+	if err != nil {
+		t.Fatalf("NewUser() error = %v", err)
+	}
+	return user
+}
+
+func TestUserRedacted(t *testing.T) {
+	tests := []struct {
+		name      string
+		userName  string
+		email     string
+		wantName  string
+		wantEmail string
+	}{
+		{name: "two names", userName: "João Silva", email: "joao@example.com", wantName: "J.S.", wantEmail: "j***@example.com"},
+		{name: "lowercase name", userName: "ana maria souza", email: "a@example.org", wantName: "A.M.S.", wantEmail: "a***@example.org"},
+		{name: "accented name", userName: "élio núñez", email: "elio@example.com", wantName: "É.N.", wantEmail: "e***@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newTestUser(t, 1, tt.userName, tt.email)
+			original := *user
+
+			redacted := user.Redacted()
+
+			if redacted.Name != tt.wantName || redacted.Email != tt.wantEmail {
+				t.Errorf("Redacted() = %q, %q, want %q, %q", redacted.Name, redacted.Email, tt.wantName, tt.wantEmail)
+			}
+			if redacted.PasswordHash != "" {
+				t.Errorf("Redacted().PasswordHash = %q, want empty", redacted.PasswordHash)
+			}
+			if *user != original {
+				t.Errorf("Redacted() mutated the original user: %+v", *user)
+			}
+		})
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := map[string]string{
+		"joao@example.com": "j***@example.com",
+		"@example.com":     "***",
+		"not-an-email":     "***",
+		"":                 "***",
+	}
+
+	for email, want := range tests {
+		if got := maskEmail(email); got != want {
+			t.Errorf("maskEmail(%q) = %q, want %q", email, got, want)
+		}
+	}
+}
+
+func TestUserVerifyEmail(t *testing.T) {
+	pending, err := NewPendingUser(testID(1), "Ana Souza", "ana@example.com", "hash", RoleUser, Easy)
+	if err != nil {
+		t.Fatalf("NewPendingUser() error = %v", err)
+	}
+
+	if !pending.IsPending() || pending.IsActive() {
+		t.Fatalf("NewPendingUser() status = %q, want %q", pending.Status, StatusPending)
+	}
+	if err := pending.Validate(); err != nil {
+		t.Errorf("Validate() on pending user error = %v", err)
+	}
+
+	if err := pending.VerifyEmail(); err != nil {
+		t.Fatalf("VerifyEmail() error = %v", err)
+	}
+	if !pending.IsActive() {
+		t.Errorf("status after VerifyEmail() = %q, want %q", pending.Status, StatusActive)
+	}
+
+	if err := pending.VerifyEmail(); !errors.Is(err, ErrUserNotPending) {
+		t.Errorf("second VerifyEmail() error = %v, want %v", err, ErrUserNotPending)
+	}
+}
+
+func TestUserFilterMatches(t *testing.T) {
+	admin := newTestUser(t, 1, "Márcia Oliveira", "marcia@example.com")
+	admin.Role = RoleAdmin
+	user := newTestUser(t, 2, "Marcos Pereira", "marcos@example.com")
+	pending := newTestUser(t, 3, "Ana Marçal", "ana@example.com")
+	pending.Status = StatusPending
+
+	roleAdmin, roleUser := RoleAdmin, RoleUser
+	statusActive, statusPending := StatusActive, StatusPending
+
+	tests := []struct {
+		name   string
+		filter UserFilter
+		want   []*User
+	}{
+		{name: "empty filter", filter: UserFilter{}, want: []*User{admin, user, pending}},
+		{name: "role", filter: UserFilter{Role: &roleUser}, want: []*User{user, pending}},
+		{name: "accent-insensitive name", filter: UserFilter{NameContains: "MARC"}, want: []*User{admin, user, pending}},
+		{name: "role and name", filter: UserFilter{Role: &roleUser, NameContains: "marc"}, want: []*User{user, pending}},
+		{name: "role, status and name", filter: UserFilter{Role: &roleUser, Status: &statusActive, NameContains: "marc"}, want: []*User{user}},
+		{name: "status and name", filter: UserFilter{Status: &statusPending, NameContains: "oliveira"}},
+		{name: "admin and name", filter: UserFilter{Role: &roleAdmin, NameContains: "marcia"}, want: []*User{admin}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []*User
+			for _, u := range []*User{admin, user, pending} {
+				if tt.filter.Matches(u) {
+					got = append(got, u)
+				}
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Matches() selected %d users, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Matches() selected %q, want %q", got[i].Name, tt.want[i].Name)
+				}
+			}
+		})
+	}
+}
+
+func TestUserAnonymize(t *testing.T) {
+	user := newTestUser(t, 7, "João Silva", "joao@example.com")
+
+	user.Anonymize()
+
+	if err := user.Validate(); err != nil {
+		t.Fatalf("Validate() after Anonymize() error = %v", err)
+	}
+	if user.ID != testID(7) {
+		t.Errorf("ID = %q, want %q", user.ID, testID(7))
+	}
+	if user.Name != "Deleted User" {
+		t.Errorf("Name = %q, want %q", user.Name, "Deleted User")
+	}
+	if want := "deleted+" + testID(7) + "@example.invalid"; user.Email != want {
+		t.Errorf("Email = %q, want %q", user.Email, want)
+	}
+	if user.PasswordHash != "" {
+		t.Errorf("PasswordHash = %q, want empty", user.PasswordHash)
+	}
+	if !user.IsDeleted() {
+		t.Errorf("IsDeleted() = false, want true")
+	}
+}
+
+func TestDeactivateStale(t *testing.T) {
+	cutoff := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	fake := useFakeClock(t, cutoff.Add(-time.Hour))
+
+	stale := newTestUser(t, 1, "Stale User", "stale@example.com")
+	stale.RecordLogin()
+	neverLogged := newTestUser(t, 2, "Never Logged", "never@example.com")
+	staleAdmin := newTestUser(t, 3, "Stale Admin", "admin@example.com")
+	staleAdmin.Role = RoleAdmin
+	staleAdmin.RecordLogin()
+	pending := newTestUser(t, 4, "Pending User", "pending@example.com")
+	pending.Status = StatusPending
+
+	fake.Set(cutoff)
+	recent := newTestUser(t, 5, "Recent User", "recent@example.com")
+	recent.RecordLogin()
+
+	tests := []struct {
+		name          string
+		includeAdmins bool
+		want          int
+		wantInactive  []*User
+	}{
+		{name: "skip admins", want: 2, wantInactive: []*User{stale, neverLogged}},
+		{name: "include admins", includeAdmins: true, want: 3, wantInactive: []*User{stale, neverLogged, staleAdmin}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := make([]*User, 0, 5)
+			for _, u := range []*User{stale, neverLogged, staleAdmin, pending, recent} {
+				clone := *u
+				users = append(users, &clone)
+			}
+
+			if got := DeactivateStale(users, cutoff, tt.includeAdmins); got != tt.want {
+				t.Errorf("DeactivateStale() = %d, want %d", got, tt.want)
+			}
+
+			for _, u := range users {
+				wantInactive := false
+				for _, w := range tt.wantInactive {
+					wantInactive = wantInactive || w.ID == u.ID
+				}
+				if got := u.Status == StatusInactive; got != wantInactive {
+					t.Errorf("user %q inactive = %v, want %v", u.Name, got, wantInactive)
+				}
+			}
+		})
+	}
+}
+
+func TestUserPublicView(t *testing.T) {
+	user := newTestUser(t, 1, "João Silva", "joao@example.com")
+	user.RecordLogin()
+
+	public := user.PublicView()
+
+	if public.PasswordHash != "" {
+		t.Errorf("PublicView().PasswordHash = %q, want empty", public.PasswordHash)
+	}
+	if user.PasswordHash != "hash" {
+		t.Errorf("original PasswordHash = %q, want %q", user.PasswordHash, "hash")
+	}
+	if public.ID != user.ID || public.Name != user.Name || public.Email != user.Email || public.Status != user.Status {
+		t.Errorf("PublicView() = %+v, want the same public fields as %+v", public, *user)
+	}
+
+	public.Name = "Changed"
+	if user.Name != "João Silva" {
+		t.Errorf("original Name = %q after changing the view, want unchanged", user.Name)
+	}
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationContext define como os modelos são validados por ValidateCtx.
+//
+// Strict habilita as verificações de formato dos IDs (UUID v7 ou v5); quando
+// falso, apenas a presença do ID é exigida, útil em importações de dados
+// legados. Locale define o idioma das mensagens de erro retornadas.
+//
+// HTML define como os textos informados pelos usuários (conteúdos, feedbacks e
+// nomes) são tratados quanto a HTML; veja HTMLPolicy.
+//
+// RequireDistractorFeedback exige feedback em todas as opções incorretas das
+// perguntas, usado como critério de qualidade na publicação.
+type ValidationContext struct {
+	Strict                    bool
+	Locale                    string
+	HTML                      HTMLPolicy
+	RequireDistractorFeedback bool
+}
+
+// HTMLPolicy define o tratamento de HTML nos textos informados pelos usuários.
+//
+// As políticas são defesas adicionais contra XSS armazenado e não substituem o
+// escape do conteúdo na exibição.
+type HTMLPolicy int
+
+const (
+	// HTMLAllow aceita os textos sem verificar HTML.
+	HTMLAllow HTMLPolicy = iota
+	// HTMLReject rejeita textos com HTML capaz de executar código, como
+	// <script>, e aceita formatação inofensiva, como <b>.
+	HTMLReject
+	// HTMLStrip remove todas as tags dos textos com CleanText antes da criação
+	// do modelo. ValidateCtx rejeita textos que ainda contenham tags.
+	HTMLStrip
+)
+
+// DefaultValidationContext é o contexto usado por Validate: estrito e com
+// mensagens em inglês.
+var DefaultValidationContext = ValidationContext{Strict: true, Locale: LangEnglish}
+
+// validateID verifica o formato do ID apenas em contextos estritos.
+//
+// Em caso de erro retorna ErrInvalidID.
+func (c ValidationContext) validateID(id string) error {
+	if !c.Strict {
+		return nil
+	}
+	return validateID(id)
+}
+
+// CleanText aplica a política de HTML do contexto a um texto informado pelo
+// usuário, antes da criação do modelo: em HTMLStrip retorna o texto sem tags
+// (SanitizeText); em HTMLReject rejeita HTML inseguro; em HTMLAllow retorna o
+// texto sem alteração.
+//
+// Em caso de erro retorna ErrUnsafeContent.
+func (c ValidationContext) CleanText(s string) (string, error) {
+	switch c.HTML {
+	case HTMLStrip:
+		return SanitizeText(s), nil
+	case HTMLReject:
+		if containsUnsafeHTML(s) {
+			return s, ErrUnsafeContent
+		}
+	}
+	return s, nil
+}
+
+// validateSafeText verifica se o texto respeita a política de HTML do
+// contexto: em HTMLReject não pode conter HTML inseguro e em HTMLStrip não
+// pode conter tags, ou seja, deve ter passado por CleanText.
+//
+// Em caso de erro retorna ErrUnsafeContent ou ErrHTMLNotAllowed.
+func (c ValidationContext) validateSafeText(s string) error {
+	switch c.HTML {
+	case HTMLStrip:
+		if containsHTML(s) {
+			return ErrHTMLNotAllowed
+		}
+	case HTMLReject:
+		if containsUnsafeHTML(s) {
+			return ErrUnsafeContent
+		}
+	}
+	return nil
+}
+
+// validateDistractorFeedback verifica se as opções incorretas possuem
+// feedback quando o contexto o exige.
+//
+// Em caso de erro retorna ValidationError com um ErrMissingDistractorFeedback
+// por opção incorreta sem feedback.
+func (c ValidationContext) validateDistractorFeedback(options []Option) error {
+	if !c.RequireDistractorFeedback {
+		return nil
+	}
+
+	ve := &ValidationError{}
+	for _, opt := range options {
+		if !opt.IsCorrect && strings.TrimSpace(opt.Feedback) == "" {
+			ve.Add(fmt.Errorf("option %q: %w", opt.ID, ErrMissingDistractorFeedback))
+		}
+	}
+
+	if ve.HasErrors() {
+		return ve
+	}
+	return nil
+}
+
+// localize encapsula err em um LocalizedError no idioma do contexto. Erros em
+// inglês (ou sem idioma definido) são retornados sem alteração.
+func (c ValidationContext) localize(err error) error {
+	if err == nil || c.Locale == "" || normalizeLang(c.Locale) == LangEnglish {
+		return err
+	}
+	return &LocalizedError{Err: err, Lang: c.Locale}
+}
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidateCtxStrictness(t *testing.T) {
+	strict := ValidationContext{Strict: true}
+	lenient := ValidationContext{}
+
+	legacy := *newTestQuestion(t, Medium, "42", "24")
+	legacy.ID = "legacy-question-1"
+	legacy.Options = legacy.OptionsView()
+	for i := range legacy.Options {
+		legacy.Options[i].ID = fmt.Sprintf("legacy-option-%d", i+1)
+		legacy.Options[i].QuestionID = legacy.ID
+	}
+
+	emptyContent := legacy
+	emptyContent.Content = ""
+
+	tests := []struct {
+		name     string
+		question Question
+		ctx      ValidationContext
+		want     []error
+		wantNot  []error
+	}{
+		{name: "strict rejects legacy IDs", question: legacy, ctx: strict, want: []error{ErrInvalidID}},
+		{name: "lenient accepts legacy IDs", question: legacy, ctx: lenient},
+		{name: "lenient still validates content", question: emptyContent, ctx: lenient, want: []error{ErrEmptyQuestionContent}, wantNot: []error{ErrInvalidID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.question.ValidateCtx(tt.ctx)
+			if len(tt.want) == 0 && err != nil {
+				t.Fatalf("ValidateCtx() error = %v, want nil", err)
+			}
+			for _, want := range tt.want {
+				if !errors.Is(err, want) {
+					t.Errorf("ValidateCtx() error = %v, want %v", err, want)
+				}
+			}
+			for _, unwanted := range tt.wantNot {
+				if errors.Is(err, unwanted) {
+					t.Errorf("ValidateCtx() error = %v, want no %v", err, unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCtxLocale(t *testing.T) {
+	subject := Subject{ID: testID(1), Name: "x"}
+
+	tests := []struct {
+		name          string
+		locale        string
+		want          string
+		wantLocalized bool
+	}{
+		{name: "default", want: "validation failed: subject name cannot be less than 3 characters"},
+		{name: "english", locale: LangEnglish, want: "validation failed: subject name cannot be less than 3 characters"},
+		{name: "portuguese", locale: LangPortuguese, want: "falha na validação: " + portugueseMessages["INVALID_SUBJECT_NAME"], wantLocalized: true},
+		{name: "portuguese short tag", locale: "pt", want: "falha na validação: " + portugueseMessages["INVALID_SUBJECT_NAME"], wantLocalized: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := subject.ValidateCtx(ValidationContext{Strict: true, Locale: tt.locale})
+			if err == nil {
+				t.Fatalf("ValidateCtx() error = nil, want error")
+			}
+			if err.Error() != tt.want {
+				t.Errorf("ValidateCtx() error = %q, want %q", err, tt.want)
+			}
+			if !errors.Is(err, ErrInvalidSubjectName) {
+				t.Errorf("errors.Is(%v, ErrInvalidSubjectName) = false, want true", err)
+			}
+
+			var le *LocalizedError
+			if got := errors.As(err, &le); got != tt.wantLocalized {
+				t.Errorf("errors.As(*LocalizedError) = %v, want %v", got, tt.wantLocalized)
+			}
+		})
+	}
+}
+
+func TestValidateCtxDistractorFeedback(t *testing.T) {
+	required := ValidationContext{Strict: true, RequireDistractorFeedback: true}
+	optional := ValidationContext{Strict: true}
+
+	// withFeedback retorna uma cópia da pergunta com os feedbacks informados,
+	// na ordem das opções.
+	withFeedback := func(feedbacks ...string) Question {
+		q := *newTestQuestion(t, Medium, "42", "24", "12")
+		q.Options = q.OptionsView()
+		for i, feedback := range feedbacks {
+			q.Options[i].Feedback = feedback
+		}
+		return q
+	}
+
+	tests := []struct {
+		name      string
+		question  Question
+		ctx       ValidationContext
+		wantCount int
+	}{
+		{name: "not required", question: withFeedback(), ctx: optional},
+		{name: "required without feedback", question: withFeedback(), ctx: required, wantCount: 2},
+		{name: "required with partial feedback", question: withFeedback("", "24 is 6 x 4"), ctx: required, wantCount: 1},
+		{name: "blank feedback is missing", question: withFeedback("", "24 is 6 x 4", "   "), ctx: required, wantCount: 1},
+		{name: "correct option needs no feedback", question: withFeedback("", "24 is 6 x 4", "12 is 6 x 2"), ctx: required},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.question.ValidateCtx(tt.ctx)
+			if tt.wantCount == 0 {
+				if err != nil {
+					t.Fatalf("ValidateCtx() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("ValidateCtx() error = %v, want ValidationError", err)
+			}
+			count := 0
+			for _, e := range ve.Errors {
+				if errors.Is(e, ErrMissingDistractorFeedback) {
+					count++
+				}
+			}
+			if count != tt.wantCount || len(ve.Errors) != tt.wantCount {
+				t.Errorf("ValidateCtx() errors = %v, want %d ErrMissingDistractorFeedback", ve.Errors, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"slices"
+
+	"educational-reinforcement-platform/pkg"
+)
+
+// Limites de paginação
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Page define os parâmetros de uma consulta paginada.
+//
+// Cursor é o ID do último item da página anterior; vazio indica a primeira
+// página. Os itens são ordenados pelo ID (UUID v7), o que corresponde à ordem
+// de criação.
+type Page struct {
+	Limit  int
+	Cursor string
+}
+
+// PageResult contém os itens de uma página e o cursor para a próxima.
+//
+// NextCursor é o ID do último item retornado e deve ser usado como Cursor da
+// próxima consulta quando HasMore for verdadeiro.
+type PageResult[T any] struct {
+	Items      []T
+	NextCursor string
+	HasMore    bool
+}
+
+// limit retorna o limite efetivo da página, aplicando o padrão e o máximo.
+func (p Page) limit() int {
+	switch {
+	case p.Limit <= 0:
+		return DefaultPageLimit
+	case p.Limit > MaxPageLimit:
+		return MaxPageLimit
+	default:
+		return p.Limit
+	}
+}
+
+// Paginate é a implementação de referência, em memória, da paginação por
+// cursor. Ordena os itens pelo ID obtido com idOf e retorna os que vêm depois
+// do cursor, respeitando o limite da página.
+func Paginate[T any](items []T, idOf func(T) string, page Page) PageResult[T] {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return pkg.CompareUUIDv7(idOf(a), idOf(b))
+	})
+
+	start := 0
+	if page.Cursor != "" {
+		start = len(sorted)
+		for i, item := range sorted {
+			if pkg.CompareUUIDv7(idOf(item), page.Cursor) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	end := min(start+page.limit(), len(sorted))
+	result := PageResult[T]{
+		Items:   sorted[start:end],
+		HasMore: end < len(sorted),
+	}
+	if len(result.Items) > 0 {
+		result.NextCursor = idOf(result.Items[len(result.Items)-1])
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// testIDs retorna n UUIDs v7 sequenciais em ordem decrescente, para que a
+// paginação precise ordená-los.
+func testIDs(n int) []string {
+	ids := make([]string, 0, n)
+	for i := n; i >= 1; i-- {
+		ids = append(ids, fmt.Sprintf("00000000-0000-7000-8000-%012x", i))
+	}
+	return ids
+}
+
+func TestPaginate(t *testing.T) {
+	identity := func(id string) string { return id }
+	ids := testIDs(5)
+	sorted := slices.Clone(ids)
+	slices.Reverse(sorted)
+	many := testIDs(MaxPageLimit + 1)
+	manySorted := slices.Clone(many)
+	slices.Reverse(manySorted)
+
+	tests := []struct {
+		name       string
+		items      []string
+		page       Page
+		want       []string
+		wantCursor string
+		wantMore   bool
+	}{
+		{name: "first page", items: ids, page: Page{Limit: 2}, want: sorted[:2], wantCursor: sorted[1], wantMore: true},
+		{name: "middle page", items: ids, page: Page{Limit: 2, Cursor: sorted[1]}, want: sorted[2:4], wantCursor: sorted[3], wantMore: true},
+		{name: "last page", items: ids, page: Page{Limit: 2, Cursor: sorted[3]}, want: sorted[4:], wantCursor: sorted[4]},
+		{name: "past the end", items: ids, page: Page{Limit: 2, Cursor: sorted[4]}, want: []string{}},
+		{name: "default limit", items: many, want: manySorted[:DefaultPageLimit], wantCursor: manySorted[DefaultPageLimit-1], wantMore: true},
+		{name: "limit capped", items: many, page: Page{Limit: MaxPageLimit + 1}, want: manySorted[:MaxPageLimit], wantCursor: manySorted[MaxPageLimit-1], wantMore: true},
+		{name: "empty", page: Page{Limit: 2}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Paginate(tt.items, identity, tt.page)
+
+			if !slices.Equal(got.Items, tt.want) {
+				t.Errorf("Items = %v, want %v", got.Items, tt.want)
+			}
+			if got.HasMore != tt.wantMore {
+				t.Errorf("HasMore = %v, want %v", got.HasMore, tt.wantMore)
+			}
+			if got.NextCursor != tt.wantCursor {
+				t.Errorf("NextCursor = %q, want %q", got.NextCursor, tt.wantCursor)
+			}
+		})
+	}
+}
+
+func TestPaginateAllPages(t *testing.T) {
+	ids := testIDs(7)
+
+	var seen []string
+	page := Page{Limit: 3}
+	for pages := 1; ; pages++ {
+		if pages > len(ids) {
+			t.Fatalf("Paginate() did not finish after %d pages", pages)
+		}
+
+		result := Paginate(ids, func(id string) string { return id }, page)
+		seen = append(seen, result.Items...)
+		if !result.HasMore {
+			break
+		}
+		page.Cursor = result.NextCursor
+	}
+
+	want := slices.Clone(ids)
+	slices.Reverse(want)
+	if !slices.Equal(seen, want) {
+		t.Errorf("items across pages = %v, want %v", seen, want)
+	}
+}
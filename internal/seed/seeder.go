@@ -0,0 +1,121 @@
+package seed
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"educational-reinforcement-platform/internal/domain/model"
+	"educational-reinforcement-platform/pkg"
+)
+
+// seedPasswordHash é o hash de senha atribuído a todos os usuários gerados.
+const seedPasswordHash = "seed-password-hash"
+
+// Seeder gera dados de teste válidos e determinísticos.
+//
+// Dois Seeders criados com a mesma semente produzem os mesmos dados, com
+// exceção dos timestamps definidos pelos construtores do modelo.
+type Seeder struct {
+	rng *rand.Rand
+	ids *pkg.SequentialGenerator
+}
+
+// NewSeeder cria uma nova instância de Seeder a partir de uma semente.
+func NewSeeder(seed uint64) *Seeder {
+	return &Seeder{
+		rng: rand.New(rand.NewPCG(seed, seed)),
+		ids: &pkg.SequentialGenerator{},
+	}
+}
+
+// nextID retorna o próximo UUID sequencial no formato v7.
+func (s *Seeder) nextID() string {
+	id, _ := s.ids.Generate()
+	return id
+}
+
+// difficulty retorna um nível de dificuldade aleatório.
+func (s *Seeder) difficulty() model.Difficulty {
+	return model.VeryEasy + model.Difficulty(s.rng.IntN(int(model.VeryHard-model.VeryEasy)+1))
+}
+
+// Users gera n usuários válidos. O primeiro usuário gerado é administrador.
+//
+// Em caso de erro retorna o ValidationError do modelo.
+func (s *Seeder) Users(n int) ([]*model.User, error) {
+	users := make([]*model.User, 0, n)
+	for i := 1; i <= n; i++ {
+		role := model.RoleUser
+		if i == 1 {
+			role = model.RoleAdmin
+		}
+
+		user, err := model.NewUser(
+			s.nextID(),
+			fmt.Sprintf("User %03d", i),
+			fmt.Sprintf("user%03d@example.com", i),
+			seedPasswordHash,
+			role,
+			s.difficulty(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("[seed.Users] ERROR: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// SubjectsWithQuestions gera a quantidade informada de disciplinas, cada uma
+// com questionsPer perguntas válidas e publicadas, prontas para a seleção de
+// quizzes.
+//
+// Em caso de erro retorna o ValidationError do modelo.
+func (s *Seeder) SubjectsWithQuestions(subjects, questionsPer int) ([]*model.Subject, []*model.Question, error) {
+	subjectList := make([]*model.Subject, 0, subjects)
+	questionList := make([]*model.Question, 0, subjects*questionsPer)
+
+	for i := 1; i <= subjects; i++ {
+		subject, err := model.NewSubject(s.nextID(), fmt.Sprintf("Subject %03d", i))
+		if err != nil {
+			return nil, nil, fmt.Errorf("[seed.SubjectsWithQuestions] ERROR: %w", err)
+		}
+		subjectList = append(subjectList, subject)
+
+		for j := 1; j <= questionsPer; j++ {
+			question, err := s.question(subject.ID, fmt.Sprintf("Question %03d.%03d", i, j))
+			if err != nil {
+				return nil, nil, fmt.Errorf("[seed.SubjectsWithQuestions] ERROR: %w", err)
+			}
+			questionList = append(questionList, question)
+		}
+	}
+	return subjectList, questionList, nil
+}
+
+// question gera uma pergunta válida e publicada, com opções, para a
+// disciplina informada.
+func (s *Seeder) question(subjectID, content string) (*model.Question, error) {
+	questionID := s.nextID()
+	difficulty := s.difficulty()
+	count := int(model.VeryEasy) + s.rng.IntN(int(difficulty)-int(model.VeryEasy)+1)
+	correct := s.rng.IntN(count)
+
+	options := make([]model.Option, 0, count)
+	for k := 0; k < count; k++ {
+		option, err := model.NewOption(s.nextID(), questionID, fmt.Sprintf("Option %d", k+1), k == correct)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, *option)
+	}
+
+	question, err := model.NewQuestion(questionID, subjectID, content, difficulty, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := question.Publish(); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
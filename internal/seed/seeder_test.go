@@ -0,0 +1,90 @@
+package seed
+
+import (
+	"testing"
+
+	"educational-reinforcement-platform/internal/domain/model"
+)
+
+func TestSeederUsers(t *testing.T) {
+	users, err := NewSeeder(42).Users(5)
+	if err != nil {
+		t.Fatalf("Users() error = %v", err)
+	}
+
+	again, err := NewSeeder(42).Users(5)
+	if err != nil {
+		t.Fatalf("Users() error = %v", err)
+	}
+
+	for i, user := range users {
+		if err := user.Validate(); err != nil {
+			t.Errorf("users[%d].Validate() error = %v", i, err)
+		}
+		if user.ID != again[i].ID || user.Email != again[i].Email || user.Difficulty != again[i].Difficulty {
+			t.Errorf("users[%d] differs between seeders with the same seed", i)
+		}
+	}
+	if !users[0].IsAdmin() {
+		t.Errorf("users[0] is not an admin")
+	}
+}
+
+func TestSeederSubjectsWithQuestions(t *testing.T) {
+	subjects, questions, err := NewSeeder(7).SubjectsWithQuestions(2, 10)
+	if err != nil {
+		t.Fatalf("SubjectsWithQuestions() error = %v", err)
+	}
+
+	if len(subjects) != 2 || len(questions) != 20 {
+		t.Fatalf("got %d subjects and %d questions, want 2 and 20", len(subjects), len(questions))
+	}
+
+	pool := make([]model.Question, 0, len(questions))
+	for i, q := range questions {
+		if err := q.CheckIntegrity(); err != nil {
+			t.Errorf("questions[%d].CheckIntegrity() error = %v", i, err)
+		}
+		if !q.IsPublished() {
+			t.Errorf("questions[%d] is not published", i)
+		}
+		pool = append(pool, *q)
+	}
+
+	for _, subject := range subjects {
+		var bundle []*model.Question
+		for _, q := range questions {
+			if q.SubjectID == subject.ID {
+				bundle = append(bundle, q)
+			}
+		}
+		if err := model.ValidateBundle(subject, bundle); err != nil {
+			t.Errorf("ValidateBundle(%q) error = %v", subject.Name, err)
+		}
+	}
+
+	selected, err := model.SelectBalanced(pool, 10, 1)
+	if err != nil {
+		t.Fatalf("SelectBalanced() error = %v", err)
+	}
+	if len(selected) != 10 {
+		t.Errorf("len(SelectBalanced()) = %d, want 10", len(selected))
+	}
+}
+
+func TestSeederDeterministic(t *testing.T) {
+	_, first, err := NewSeeder(3).SubjectsWithQuestions(2, 5)
+	if err != nil {
+		t.Fatalf("SubjectsWithQuestions() error = %v", err)
+	}
+	_, second, err := NewSeeder(3).SubjectsWithQuestions(2, 5)
+	if err != nil {
+		t.Fatalf("SubjectsWithQuestions() error = %v", err)
+	}
+
+	for i := range first {
+		if first[i].ContentHash() != second[i].ContentHash() || first[i].ID != second[i].ID {
+			t.Errorf("questions[%d] differs between seeders with the same seed", i)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock define uma fonte de tempo, permitindo substituir time.Now em testes.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock é o Clock baseado no relógio do sistema.
+type realClock struct{}
+
+// Now retorna o instante atual do relógio do sistema.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Real retorna o Clock baseado no relógio do sistema.
+func Real() Clock {
+	return realClock{}
+}
+
+// FakeClock é um Clock controlado manualmente, destinado a testes.
+type FakeClock struct {
+	mu      sync.Mutex
+	current time.Time
+}
+
+// NewFakeClock cria um FakeClock parado no instante informado.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{current: t}
+}
+
+// Now retorna o instante atual do relógio.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.current
+}
+
+// Advance avança o relógio pela duração informada.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current = c.current.Add(d)
+}
+
+// Set posiciona o relógio no instante informado.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current = t
+}
@@ -0,0 +1,36 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want %v", got, start)
+	}
+
+	c.Advance(90 * time.Minute)
+	if want := start.Add(90 * time.Minute); !c.Now().Equal(want) {
+		t.Errorf("Now() after Advance() = %v, want %v", c.Now(), want)
+	}
+
+	later := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c.Set(later)
+	if got := c.Now(); !got.Equal(later) {
+		t.Errorf("Now() after Set() = %v, want %v", got, later)
+	}
+}
+
+func TestRealClock(t *testing.T) {
+	before := time.Now()
+	got := Real().Now()
+	after := time.Now()
+
+	if got.Before(before) || got.After(after) {
+		t.Errorf("Real().Now() = %v, want between %v and %v", got, before, after)
+	}
+}
@@ -0,0 +1,61 @@
+package pkg
+
+import (
+	"fmt"
+	"sync"
+)
+
+// IDGenerator define um gerador de identificadores únicos.
+type IDGenerator interface {
+	Generate() (string, error)
+}
+
+// UUIDv7Generator gera identificadores usando GenerateUUIDv7.
+type UUIDv7Generator struct{}
+
+// Generate retorna um novo UUID v7.
+func (UUIDv7Generator) Generate() (string, error) {
+	return GenerateUUIDv7()
+}
+
+// SequentialGenerator gera UUIDs v7 sequenciais e determinísticos, no formato
+// 00000000-0000-7000-8000-00000000000N, destinado a testes.
+type SequentialGenerator struct {
+	mu      sync.Mutex
+	counter uint64
+}
+
+// Generate retorna o próximo UUID da sequência.
+func (g *SequentialGenerator) Generate() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.counter++
+	return fmt.Sprintf("00000000-0000-7000-8000-%012x", g.counter), nil
+}
+
+var (
+	defaultGeneratorMu sync.RWMutex
+	defaultGenerator   IDGenerator = UUIDv7Generator{}
+)
+
+// SetDefaultIDGenerator substitui o gerador padrão usado por NewID.
+//
+// Passar nil restaura o gerador padrão baseado em GenerateUUIDv7.
+func SetDefaultIDGenerator(g IDGenerator) {
+	defaultGeneratorMu.Lock()
+	defer defaultGeneratorMu.Unlock()
+
+	if g == nil {
+		g = UUIDv7Generator{}
+	}
+	defaultGenerator = g
+}
+
+// NewID gera um novo identificador usando o gerador padrão.
+func NewID() (string, error) {
+	defaultGeneratorMu.RLock()
+	defer defaultGeneratorMu.RUnlock()
+
+	return defaultGenerator.Generate()
+}
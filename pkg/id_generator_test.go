@@ -0,0 +1,43 @@
+package pkg
+
+import (
+	"testing"
+)
+
+func TestSequentialGenerator(t *testing.T) {
+	g := &SequentialGenerator{}
+
+	want := []string{
+		"00000000-0000-7000-8000-000000000001",
+		"00000000-0000-7000-8000-000000000002",
+		"00000000-0000-7000-8000-000000000003",
+	}
+	for i, w := range want {
+		got, err := g.Generate()
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if got != w {
+			t.Errorf("Generate() #%d = %q, want %q", i+1, got, w)
+		}
+		if !IsValidUUIDv7(got) {
+			t.Errorf("Generate() #%d = %q is not a valid UUID v7", i+1, got)
+		}
+	}
+}
+
+func TestSetDefaultIDGenerator(t *testing.T) {
+	t.Cleanup(func() { SetDefaultIDGenerator(nil) })
+
+	SetDefaultIDGenerator(&SequentialGenerator{})
+	id, err := NewID()
+	if err != nil || id != "00000000-0000-7000-8000-000000000001" {
+		t.Errorf("NewID() with SequentialGenerator = %q, %v", id, err)
+	}
+
+	SetDefaultIDGenerator(nil)
+	id, err = NewID()
+	if err != nil || !IsValidUUIDv7(id) || id == "00000000-0000-7000-8000-000000000002" {
+		t.Errorf("NewID() after reset = %q, %v, want a random UUID v7", id, err)
+	}
+}
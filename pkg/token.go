@@ -0,0 +1,74 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Erros específicos de tokens
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrEmptySecret  = errors.New("token secret cannot be empty")
+)
+
+// SignToken cria um token opaco e à prova de adulteração com o payload
+// informado, no formato <payload>.<assinatura>: o payload em JSON codificado em
+// base64url e a assinatura HMAC-SHA256 do payload codificado.
+//
+// Em caso de erro retorna ErrEmptySecret ou o erro de serialização.
+func SignToken(payload map[string]string, secret []byte) (string, error) {
+	if len(secret) == 0 {
+		return "", ErrEmptySecret
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("[SignToken] ERROR: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(tokenSignature(encoded, secret)), nil
+}
+
+// VerifyToken verifica a assinatura do token gerado por SignToken e retorna o
+// payload.
+//
+// Em caso de erro retorna ErrEmptySecret ou ErrInvalidToken.
+func VerifyToken(token string, secret []byte) (map[string]string, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, tokenSignature(encoded, secret)) {
+		return nil, ErrInvalidToken
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var payload map[string]string
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+	return payload, nil
+}
+
+// tokenSignature calcula a assinatura HMAC-SHA256 do payload codificado.
+func tokenSignature(encoded string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
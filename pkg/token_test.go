@@ -0,0 +1,74 @@
+package pkg
+
+import (
+	"encoding/base64"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestSignAndVerifyToken(t *testing.T) {
+	secret := []byte("test-secret")
+	payload := map[string]string{"subjectId": "00000000-0000-7000-8000-000000000001", "difficulty": "Medium"}
+
+	token, err := SignToken(payload, secret)
+	if err != nil {
+		t.Fatalf("SignToken() error = %v", err)
+	}
+
+	got, err := VerifyToken(token, secret)
+	if err != nil {
+		t.Fatalf("VerifyToken() error = %v", err)
+	}
+	if !maps.Equal(got, payload) {
+		t.Errorf("VerifyToken() = %v, want %v", got, payload)
+	}
+}
+
+func TestVerifyTokenTampered(t *testing.T) {
+	secret := []byte("test-secret")
+	token, err := SignToken(map[string]string{"difficulty": "Medium"}, secret)
+	if err != nil {
+		t.Fatalf("SignToken() error = %v", err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"difficulty":"Very Hard"}`))
+	flipped := []byte(signature)
+	if flipped[0] == 'A' {
+		flipped[0] = 'B'
+	} else {
+		flipped[0] = 'A'
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		secret []byte
+		want   error
+	}{
+		{name: "tampered payload", token: forged + "." + signature, secret: secret, want: ErrInvalidToken},
+		{name: "tampered signature", token: encoded + "." + string(flipped), secret: secret, want: ErrInvalidToken},
+		{name: "wrong secret", token: token, secret: []byte("other-secret"), want: ErrInvalidToken},
+		{name: "missing signature", token: encoded, secret: secret, want: ErrInvalidToken},
+		{name: "invalid encoding", token: encoded + ".!!!", secret: secret, want: ErrInvalidToken},
+		{name: "empty secret", token: token, want: ErrEmptySecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := VerifyToken(tt.token, tt.secret)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifyToken() error = %v, want %v", err, tt.want)
+			}
+			if payload != nil {
+				t.Errorf("VerifyToken() payload = %v, want nil", payload)
+			}
+		})
+	}
+
+	if _, err := SignToken(map[string]string{}, nil); !errors.Is(err, ErrEmptySecret) {
+		t.Errorf("SignToken(nil secret) error = %v, want %v", err, ErrEmptySecret)
+	}
+}
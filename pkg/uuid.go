@@ -2,29 +2,91 @@ package pkg
 
 import (
 	"crypto/rand"
+	"crypto/sha1"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 	"time"
 )
 
+// uuidState guarda o último UUID v7 gerado para garantir a ordem monotônica.
+var uuidState struct {
+	mu   sync.Mutex
+	last [16]byte
+}
+
 // GenerateUUIDv7 cria um UUID v7 baseado em timestamp e aleatório.
+//
+// Os UUIDs gerados são estritamente crescentes: quando o timestamp em
+// milissegundos não avança em relação ao último UUID, o bloco aleatório do
+// último UUID é incrementado em 1 (método monotônico da RFC 9562).
 func GenerateUUIDv7() (string, error) {
-	var uuid [16]byte
+	uuidState.mu.Lock()
+	defer uuidState.mu.Unlock()
+
+	uuid, err := nextUUIDv7()
+	if err != nil {
+		return "", fmt.Errorf("[GenerateUUIDv7] ERROR: %w", err)
+	}
+	return formatUUID(uuid), nil
+}
+
+// GenerateUUIDv7Batch cria n UUIDs v7 em ordem estritamente crescente.
+//
+// O lote é gerado de forma atômica em relação a outras chamadas, de modo que
+// nenhum outro UUID é intercalado na sequência.
+func GenerateUUIDv7Batch(n int) ([]string, error) {
+	if n <= 0 {
+		return []string{}, nil
+	}
 
-	// Adiciona o timestamp (48 bits de tempo em milissegundos desde a época)
+	uuidState.mu.Lock()
+	defer uuidState.mu.Unlock()
+
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		uuid, err := nextUUIDv7()
+		if err != nil {
+			return nil, fmt.Errorf("[GenerateUUIDv7Batch] ERROR: %w", err)
+		}
+		ids = append(ids, formatUUID(uuid))
+	}
+	return ids, nil
+}
+
+// nextUUIDv7 gera o próximo UUID v7 monotônico e o registra como último.
+//
+// Deve ser chamada com uuidState.mu bloqueado.
+func nextUUIDv7() ([16]byte, error) {
 	timestamp := uint64(time.Now().UnixMilli())
 
+	var uuid [16]byte
+	if timestamp <= uuidTimestamp(uuidState.last) {
+		uuid = incrementUUIDv7(uuidState.last)
+	} else {
+		var err error
+		uuid, err = newUUIDv7(timestamp)
+		if err != nil {
+			return uuid, err
+		}
+	}
+
+	uuidState.last = uuid
+	return uuid, nil
+}
+
+// newUUIDv7 cria os bytes de um UUID v7 com o timestamp informado e o
+// restante aleatório.
+func newUUIDv7(timestamp uint64) ([16]byte, error) {
+	var uuid [16]byte
+
 	// Preencher os primeiros 6 bytes com o timestamp (48 bits)
-	uuid[0] = byte(timestamp >> 40)
-	uuid[1] = byte(timestamp >> 32)
-	uuid[2] = byte(timestamp >> 24)
-	uuid[3] = byte(timestamp >> 16)
-	uuid[4] = byte(timestamp >> 8)
-	uuid[5] = byte(timestamp)
+	setUUIDTimestamp(&uuid, timestamp)
 
 	// Preencher os bytes restantes com aleatoriedade
 	if _, err := io.ReadFull(rand.Reader, uuid[6:]); err != nil {
-		return "", fmt.Errorf("[GenerateUUIDv7] ERROR: %w", err)
+		return uuid, err
 	}
 
 	// Setando a versão do UUID (v7) em 6 bits no byte 6
@@ -33,7 +95,61 @@ func GenerateUUIDv7() (string, error) {
 	// Setando os bits da variante no byte 8 (primeiros 2 bits 10)
 	uuid[8] = (uuid[8] & 0x3f) | 0x80 // 1000 0000 (variante 1)
 
-	// Retornar o UUID no formato adequado (string)
+	return uuid, nil
+}
+
+// setUUIDTimestamp grava o timestamp (48 bits de tempo em milissegundos desde
+// a época) nos primeiros 6 bytes do UUID.
+func setUUIDTimestamp(uuid *[16]byte, timestamp uint64) {
+	uuid[0] = byte(timestamp >> 40)
+	uuid[1] = byte(timestamp >> 32)
+	uuid[2] = byte(timestamp >> 24)
+	uuid[3] = byte(timestamp >> 16)
+	uuid[4] = byte(timestamp >> 8)
+	uuid[5] = byte(timestamp)
+}
+
+// uuidTimestamp lê o timestamp dos primeiros 6 bytes do UUID.
+func uuidTimestamp(uuid [16]byte) uint64 {
+	return uint64(uuid[0])<<40 | uint64(uuid[1])<<32 | uint64(uuid[2])<<24 |
+		uint64(uuid[3])<<16 | uint64(uuid[4])<<8 | uint64(uuid[5])
+}
+
+// incrementUUIDv7 retorna o UUID seguinte ao informado, incrementando em 1 os
+// 74 bits aleatórios (rand_a e rand_b) e preservando versão e variante.
+//
+// Se os bits aleatórios transbordarem, o timestamp é avançado em 1 ms.
+func incrementUUIDv7(uuid [16]byte) [16]byte {
+	// rand_b: 62 bits (6 bits do byte 8 e bytes 9 a 15)
+	randB := uint64(uuid[8]&0x3f)<<56 | uint64(uuid[9])<<48 | uint64(uuid[10])<<40 |
+		uint64(uuid[11])<<32 | uint64(uuid[12])<<24 | uint64(uuid[13])<<16 |
+		uint64(uuid[14])<<8 | uint64(uuid[15])
+	// rand_a: 12 bits (4 bits do byte 6 e byte 7)
+	randA := uint16(uuid[6]&0x0f)<<8 | uint16(uuid[7])
+
+	randB = (randB + 1) & (1<<62 - 1)
+	if randB == 0 {
+		randA = (randA + 1) & (1<<12 - 1)
+		if randA == 0 {
+			setUUIDTimestamp(&uuid, uuidTimestamp(uuid)+1)
+		}
+	}
+
+	uuid[6] = 0x70 | byte(randA>>8)
+	uuid[7] = byte(randA)
+	uuid[8] = 0x80 | byte(randB>>56)
+	uuid[9] = byte(randB >> 48)
+	uuid[10] = byte(randB >> 40)
+	uuid[11] = byte(randB >> 32)
+	uuid[12] = byte(randB >> 24)
+	uuid[13] = byte(randB >> 16)
+	uuid[14] = byte(randB >> 8)
+	uuid[15] = byte(randB)
+	return uuid
+}
+
+// formatUUID retorna o UUID no formato textual padrão.
+func formatUUID(uuid [16]byte) string {
 	return fmt.Sprintf(
 		"%08x-%04x-%04x-%04x-%012x",
 		uint32(uuid[0])<<24|uint32(uuid[1])<<16|uint32(uuid[2])<<8|uint32(uuid[3]),
@@ -41,5 +157,97 @@ func GenerateUUIDv7() (string, error) {
 		uint16(uuid[6])<<8|uint16(uuid[7]),
 		uint16(uuid[8])<<8|uint16(uuid[9]),
 		uint64(uuid[10])<<40|uint64(uuid[11])<<32|uint64(uuid[12])<<24|uint64(uuid[13])<<16|uint64(uuid[14])<<8|uint64(uuid[15]),
-	), nil
+	)
+}
+
+// CompareUUIDv7 compara dois UUIDs v7 pela ordem de criação.
+//
+// Retorna -1 se a for anterior a b, 0 se forem iguais e +1 se a for posterior
+// a b. Como o timestamp ocupa os bits mais significativos, a ordem textual
+// (sem diferenciar maiúsculas e minúsculas) corresponde à ordem de criação.
+func CompareUUIDv7(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// IsValidUUIDv7 verifica se s é um UUID v7 no formato textual padrão, com a
+// versão 7 e a variante RFC 9562.
+func IsValidUUIDv7(s string) bool {
+	return isValidUUIDVersion(s, '7')
+}
+
+// IsValidUUIDv5 verifica se s é um UUID v5 no formato textual padrão, com a
+// versão 5 e a variante RFC 4122.
+func IsValidUUIDv5(s string) bool {
+	return isValidUUIDVersion(s, '5')
+}
+
+// isValidUUIDVersion verifica se s é um UUID no formato textual padrão com a
+// versão informada e a variante RFC 4122/9562.
+func isValidUUIDVersion(s string, version byte) bool {
+	if len(s) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return false
+			}
+		}
+	}
+
+	return s[14] == version && strings.ContainsRune("89abAB", rune(s[19]))
+}
+
+// isHexDigit verifica se c é um dígito hexadecimal.
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// optionIDNamespace é o namespace usado para derivar IDs estáveis de opções.
+var optionIDNamespace = [16]byte{
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x53,
+	0x9a, 0x1d, 0x3c, 0x57, 0x2b, 0x8e, 0x40, 0xf1,
+}
+
+// Namespaces predefinidos da RFC 4122 para UUIDs baseados em nome.
+var (
+	NamespaceDNS  = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceURL  = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceOID  = [16]byte{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceX500 = [16]byte{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// GenerateUUIDv5 cria um UUID v5 (RFC 4122, SHA-1) determinístico a partir de
+// um namespace e de um nome. O mesmo par sempre produz o mesmo UUID.
+func GenerateUUIDv5(namespace [16]byte, name string) string {
+	hash := sha1.New()
+	hash.Write(namespace[:])
+	hash.Write([]byte(name))
+	sum := hash.Sum(nil)
+
+	var uuid [16]byte
+	copy(uuid[:], sum[:16])
+
+	// Setando a versão do UUID (v5) em 4 bits no byte 6
+	uuid[6] = (uuid[6] & 0x0f) | 0x50
+
+	// Setando os bits da variante no byte 8 (primeiros 2 bits 10)
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return formatUUID(uuid)
+}
+
+// StableOptionID deriva um ID determinístico (UUID v5) para uma opção a
+// partir do ID da pergunta e do conteúdo da opção.
+//
+// As mesmas entradas sempre produzem o mesmo ID, permitindo reimportações
+// idempotentes.
+func StableOptionID(questionID, content string) string {
+	return GenerateUUIDv5(optionIDNamespace, questionID+"/"+content)
 }
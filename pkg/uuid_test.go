@@ -0,0 +1,146 @@
+package pkg
+
+import (
+	"testing"
+)
+
+func TestGenerateUUIDv7Batch(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want int
+	}{
+		{name: "zero", n: 0, want: 0},
+		{name: "negative", n: -1, want: 0},
+		{name: "one", n: 1, want: 1},
+		{name: "many", n: 1000, want: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := GenerateUUIDv7Batch(tt.n)
+			if err != nil {
+				t.Fatalf("GenerateUUIDv7Batch(%d) error = %v", tt.n, err)
+			}
+			if len(ids) != tt.want {
+				t.Fatalf("len(GenerateUUIDv7Batch(%d)) = %d, want %d", tt.n, len(ids), tt.want)
+			}
+
+			for i, id := range ids {
+				if !IsValidUUIDv7(id) {
+					t.Errorf("ids[%d] = %q is not a valid UUID v7", i, id)
+				}
+				if i > 0 && CompareUUIDv7(ids[i-1], id) >= 0 {
+					t.Errorf("ids[%d] = %q is not after ids[%d] = %q", i, id, i-1, ids[i-1])
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateUUIDv7Monotonic(t *testing.T) {
+	const n = 10000
+
+	prev := ""
+	for i := 0; i < n; i++ {
+		id, err := GenerateUUIDv7()
+		if err != nil {
+			t.Fatalf("GenerateUUIDv7() error = %v", err)
+		}
+		if !IsValidUUIDv7(id) {
+			t.Fatalf("GenerateUUIDv7() #%d = %q is not a valid UUID v7", i, id)
+		}
+		if prev != "" && CompareUUIDv7(prev, id) >= 0 {
+			t.Fatalf("GenerateUUIDv7() #%d = %q is not after %q", i, id, prev)
+		}
+		prev = id
+	}
+}
+
+func TestIncrementUUIDv7(t *testing.T) {
+	tests := []struct {
+		name string
+		in   [16]byte
+		want string
+	}{
+		{
+			name: "rand_b",
+			in:   [16]byte{0x01, 0x8f, 0, 0, 0, 0x01, 0x70, 0x00, 0x80, 0, 0, 0, 0, 0, 0, 0x01},
+			want: "018f0000-0001-7000-8000-000000000002",
+		},
+		{
+			name: "rand_b overflow carries into rand_a",
+			in:   [16]byte{0x01, 0x8f, 0, 0, 0, 0x01, 0x70, 0x00, 0xbf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			want: "018f0000-0001-7001-8000-000000000000",
+		},
+		{
+			name: "rand_a overflow advances the timestamp",
+			in:   [16]byte{0x01, 0x8f, 0, 0, 0, 0x01, 0x7f, 0xff, 0xbf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			want: "018f0000-0002-7000-8000-000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatUUID(incrementUUIDv7(tt.in)); got != tt.want {
+				t.Errorf("incrementUUIDv7() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStableOptionID(t *testing.T) {
+	questionID := "00000000-0000-7000-8000-000000000001"
+
+	first := StableOptionID(questionID, "42")
+	if !IsValidUUIDv5(first) {
+		t.Fatalf("StableOptionID() = %q is not a valid UUID v5", first)
+	}
+	for i := 0; i < 3; i++ {
+		if got := StableOptionID(questionID, "42"); got != first {
+			t.Errorf("StableOptionID() = %q, want %q on every call", got, first)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		questionID string
+		content    string
+	}{
+		{name: "other content", questionID: questionID, content: "24"},
+		{name: "other question", questionID: "00000000-0000-7000-8000-000000000002", content: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StableOptionID(tt.questionID, tt.content); got == first {
+				t.Errorf("StableOptionID(%q, %q) = %q, want a different ID", tt.questionID, tt.content, got)
+			}
+		})
+	}
+}
+
+func TestGenerateUUIDv5(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace [16]byte
+		input     string
+		want      string
+	}{
+		{name: "RFC 4122 DNS vector", namespace: NamespaceDNS, input: "www.example.com", want: "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{name: "python.org", namespace: NamespaceDNS, input: "python.org", want: "886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		{name: "URL namespace", namespace: NamespaceURL, input: "http://python.org/", want: "4c565f0d-3f5a-5890-b41b-20cf47701c5e"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GenerateUUIDv5(tt.namespace, tt.input)
+			if got != tt.want {
+				t.Errorf("GenerateUUIDv5(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if !IsValidUUIDv5(got) {
+				t.Errorf("GenerateUUIDv5(%q) = %q is not a valid UUID v5", tt.input, got)
+			}
+		})
+	}
+}